@@ -2,13 +2,15 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
 	"flag"
 	"fmt"
 	"log"
 	"net/http"
 	"os"
+	"strconv"
+	"strings"
+	"sync"
 	"time"
 
 	"connectrpc.com/connect"
@@ -18,104 +20,225 @@ import (
 	"github.com/machimachida/grpc-planning-poker/gen/proto/v1/pokerv1connect"
 )
 
-const (
-	AVERAGE = "average"
-)
-
 var (
-	roomId string
+	roomId  string
+	session = &sessionInterceptor{}
 )
 
+// sessionHeader サーバから発行されたセッショントークンをやり取りするヘッダ
+const sessionHeader = "Planning-Poker-Session"
+
+// sessionInterceptor サーバから発行されたセッショントークンを覚えておき、全てのリクエストのヘッダに付ける
+type sessionInterceptor struct {
+	mu    sync.Mutex
+	token string
+}
+
+func (i *sessionInterceptor) set(token string) {
+	if token == "" {
+		return
+	}
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.token = token
+}
+
+func (i *sessionInterceptor) get() string {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.token
+}
+
+func (i *sessionInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if token := i.get(); token != "" {
+			req.Header().Set(sessionHeader, token)
+		}
+		return next(ctx, req)
+	}
+}
+
+func (i *sessionInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return func(ctx context.Context, spec connect.Spec) connect.StreamingClientConn {
+		conn := next(ctx, spec)
+		if token := i.get(); token != "" {
+			conn.RequestHeader().Set(sessionHeader, token)
+		}
+		return conn
+	}
+}
+
+func (i *sessionInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return next
+}
+
 func main() {
 	name := flag.String("name", "Taro", "name of user")
 	waitSecond := flag.Int("wait", 600, "wait second")
 	isCreatingRoom := flag.Bool("create", false, "create room")
-	joinRoomId := flag.String("join", "", "join room id")
+	joinRoomId := flag.String("join", "", "join room id or invite code")
+	observer := flag.Bool("observer", false, "join the room as an observer who does not vote")
+	deckName := flag.String("deck", "fibonacci", "deck of the room to create. fibonacci, modified-fibonacci, t-shirt, powers-of-two or comma separated card labels")
+	autoReveal := flag.Int("auto-reveal", -1, "seconds to wait before revealing the votes once everyone has voted in the room to create. disabled if negative")
+	public := flag.Bool("public", false, "list the room to create in -list")
+	list := flag.Bool("list", false, "list the public rooms and exit")
+	passphrase := flag.String("passphrase", "", "passphrase of the room to create or join")
 	flag.Parse()
 
 	client := pokerv1connect.NewPlanningPokerServiceClient(
 		http.DefaultClient,
 		"http://localhost:8080",
+		connect.WithInterceptors(session),
 	)
 
+	if *list {
+		listRooms(client)
+		return
+	}
+
 	ctx, cancel := context.WithCancel(context.Background())
 	var (
 		stream *connect.ServerStreamForClient[pokerv1.ConnectResponse]
 		err    error
 	)
 	if *isCreatingRoom {
-		fmt.Print("Please input room id: ")
-		var id string
-		for {
-			_, err := fmt.Scan(&id)
-			if err == nil {
-				break
-			}
-			log.Println("failed to scan input.", err)
-			println("Please input room name.")
+		deck, err := parseDeck(*deckName)
+		if err != nil {
+			log.Fatal("invalid deck.", err)
 		}
+
+		// 空の場合はサーバがIDを生成する
+		fmt.Print("Please input room id (leave empty to generate): ")
+		id := scanLine()
 		stream, err = client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{
 			Id:     *name,
 			RoomId: id,
+			Deck:   deck,
+			Settings: &pokerv1.RoomSettings{
+				AutoReveal:             *autoReveal >= 0,
+				AutoRevealDelaySeconds: int32(max(*autoReveal, 0)),
+				Public:                 *public,
+			},
+			Passphrase: *passphrase,
 		}))
 	} else {
+		roomId = resolveRoomId(client, *joinRoomId)
 		stream, err = client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{
-			Id:     *name,
-			RoomId: *joinRoomId,
+			Id:         *name,
+			RoomId:     roomId,
+			Observer:   *observer,
+			Passphrase: *passphrase,
 		}))
-		roomId = *joinRoomId
 	}
 	if err != nil {
 		log.Fatal("failed to create or join room.", err)
 	}
-	go listenServerMessage(stream)
+	go listenServerMessage(ctx, client, *name, *passphrase, stream)
 	go disconnectAfterWaitSecond(cancel, *waitSecond)
 
 	println("Start planning poker!")
 
 	for {
-		var n int32
-		_, err := fmt.Scan(&n)
+		var in string
+		_, err := fmt.Scan(&in)
 		if err != nil {
-			log.Println("failed to scan input. Please input integer.", err)
+			log.Println("failed to scan input.", err)
 			continue
 		}
 
-		switch n {
-		case -2:
+		switch in {
+		case "-1":
+			resetVote(client, *name, roomId)
+		case "-2":
 			println("Disconnect...")
 			cancel()
-		case -3:
+		case "-3":
 			showVotes(client, *name, roomId)
 			continue
-		case -4:
+		case "-4":
 			newGame(client, *name, roomId)
 			continue
+		case "-5":
+			transferFacilitator(client, *name, roomId, scanParticipant())
+			continue
+		case "-6":
+			shareFacilitator(client, *name, roomId, scanParticipant())
+			continue
+		case "-7":
+			addStory(client, *name, roomId, scanLine())
+			continue
+		case "-8":
+			acceptEstimate(client, *name, roomId, scanParticipant())
+			continue
+		case "-9":
+			selectStory(client, *name, roomId, scanParticipant())
+			continue
+		case "-10":
+			removeStory(client, *name, roomId, scanParticipant())
+			continue
+		case "-11":
+			startTimer(client, *name, roomId, scanParticipant())
+			continue
+		case "-12":
+			leaveRoom(client, *name, roomId)
+			continue
+		case "-13":
+			kickParticipant(client, *name, roomId, scanParticipant())
+			continue
+		case "-14":
+			closeRoom(client, *name, roomId)
+			continue
+		case "-15":
+			setRoomLock(client, *name, roomId, true)
+			continue
+		case "-16":
+			setRoomLock(client, *name, roomId, false)
+			continue
 		default:
-			if n > 0 || n == -1 {
-				vote(client, *name, roomId, n)
+			if !strings.HasPrefix(in, "-") {
+				vote(client, *name, roomId, in)
 				continue
 			}
 
-			println("Please input natural number, -1(reset your vote), -2(disconnect), -3(show votes) or -4(new game).")
+			println("Please input a card in the deck, -1(reset your vote), -2(disconnect), -3(show votes), -4(new game), -5 <name>(hand over facilitator), -6 <name>(share facilitator), -7 <title>(add story), -8 <card>(accept estimate), -9 <story id>(select story), -10 <story id>(remove story), -11 <seconds>(start timer and reveal votes when it expires), -12(leave room), -13 <name>(kick participant), -14(close room), -15(lock room) or -16(unlock room).")
 		}
 	}
 }
 
-func listenServerMessage(stream *connect.ServerStreamForClient[pokerv1.ConnectResponse]) {
+// maxResumeAttempts 接続が切れた際に、再接続を試みる回数
+const maxResumeAttempts = 5
+
+func listenServerMessage(ctx context.Context, client pokerv1connect.PlanningPokerServiceClient, name, passphrase string, stream *connect.ServerStreamForClient[pokerv1.ConnectResponse]) {
+	// 最後に受信したイベントのシーケンス番号。再接続の際に、これより後のイベントを再送してもらう
+	var lastSequence uint64
+	var attempts int
+	// サーバの停止を通知されたかどうか。再起動を待って再接続する
+	var shuttingDown bool
 	for {
-		if stream.Receive() {
+		for stream.Receive() {
 			message := stream.Msg()
 			if message == nil {
 				log.Println("server sent nil message.")
 				return
 			}
+			attempts = 0
+			// 再接続するとトークンが発行し直されるので、ストリームごとに読み直す
+			session.set(stream.ResponseHeader().Get(sessionHeader))
+			// 別のインスタンスに再接続すると番号が振り直されるので、大小は比べずに最新の番号を使う
+			if message.Sequence != 0 {
+				lastSequence = message.Sequence
+			}
+			shuttingDown = message.GetServerShuttingDown() != nil
 			printlnBroadcastMessage(message)
 		}
 
 		err := stream.Err()
+		if err == nil && shuttingDown {
+			err = errors.New("server is shutting down")
+		}
 		if err == nil {
-			continue
+			log.Println("server closed the connection.")
+			os.Exit(0)
 		}
 
 		if errors.Is(err, context.Canceled) {
@@ -124,7 +247,24 @@ func listenServerMessage(stream *connect.ServerStreamForClient[pokerv1.ConnectRe
 		}
 
 		log.Println("failed to receive message.", err)
-		os.Exit(1)
+		attempts++
+		if attempts > maxResumeAttempts {
+			os.Exit(1)
+		}
+
+		// 見逃したイベントを再送してもらうように、ルームへ再接続する
+		time.Sleep(time.Duration(attempts) * time.Second)
+		println(color.YellowString(fmt.Sprintf("reconnecting... (%d/%d)", attempts, maxResumeAttempts)))
+		stream, err = client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{
+			Id:          name,
+			RoomId:      roomId,
+			ResumeAfter: lastSequence,
+			Passphrase:  passphrase,
+		}))
+		if err != nil {
+			log.Println("failed to reconnect.", err)
+			os.Exit(1)
+		}
 	}
 }
 
@@ -134,8 +274,8 @@ func disconnectAfterWaitSecond(cancel context.CancelFunc, waitSecond int) {
 	os.Exit(0)
 }
 
-func vote(client pokerv1connect.PlanningPokerServiceClient, id, roomId string, vote int32) {
-	res, err := client.Vote(context.Background(), connect.NewRequest(&pokerv1.VoteRequest{Id: id, Vote: vote, RoomId: roomId}))
+func vote(client pokerv1connect.PlanningPokerServiceClient, id, roomId, card string) {
+	res, err := client.Vote(context.Background(), connect.NewRequest(&pokerv1.VoteRequest{Id: id, Card: card, RoomId: roomId}))
 	if err != nil {
 		log.Println("failed to vote.", err)
 		return
@@ -147,6 +287,202 @@ func vote(client pokerv1connect.PlanningPokerServiceClient, id, roomId string, v
 	println(res.Msg.Message)
 }
 
+func resetVote(client pokerv1connect.PlanningPokerServiceClient, id, roomId string) {
+	res, err := client.Vote(context.Background(), connect.NewRequest(&pokerv1.VoteRequest{Id: id, Vote: -1, RoomId: roomId}))
+	if err != nil {
+		log.Println("failed to reset vote.", err)
+		return
+	}
+	if res == nil {
+		log.Println("failed to reset vote. res is nil.")
+		return
+	}
+	println(res.Msg.Message)
+}
+
+func scanParticipant() string {
+	var participant string
+	for {
+		_, err := fmt.Scan(&participant)
+		if err == nil {
+			return participant
+		}
+		log.Println("failed to scan input.", err)
+		println("Please input participant name.")
+	}
+}
+
+// scanLine 入力の行の残りを読む。ストーリーのタイトルのように空白を含む入力に使う。
+func scanLine() string {
+	var b strings.Builder
+	buf := make([]byte, 1)
+	for {
+		n, err := os.Stdin.Read(buf)
+		if err != nil || (n > 0 && buf[0] == '\n') {
+			return strings.TrimSpace(b.String())
+		}
+		b.Write(buf[:n])
+	}
+}
+
+func addStory(client pokerv1connect.PlanningPokerServiceClient, id, roomId, title string) {
+	res, err := client.AddStory(context.Background(), connect.NewRequest(&pokerv1.AddStoryRequest{Id: id, RoomId: roomId, Title: title}))
+	if err != nil {
+		log.Println("failed to add story.", err)
+		return
+	}
+	println("added story " + res.Msg.Story.Id)
+}
+
+func acceptEstimate(client pokerv1connect.PlanningPokerServiceClient, id, roomId, estimate string) {
+	res, err := client.AcceptEstimate(context.Background(), connect.NewRequest(&pokerv1.AcceptEstimateRequest{Id: id, RoomId: roomId, Estimate: estimate}))
+	if err != nil {
+		log.Println("failed to accept estimate.", err)
+		return
+	}
+	println(res.Msg.Story.Title + " is estimated as " + res.Msg.Story.Estimate)
+}
+
+func selectStory(client pokerv1connect.PlanningPokerServiceClient, id, roomId, storyId string) {
+	res, err := client.SelectStory(context.Background(), connect.NewRequest(&pokerv1.SelectStoryRequest{Id: id, RoomId: roomId, StoryId: storyId}))
+	if err != nil {
+		log.Println("failed to select story.", err)
+		return
+	}
+	println(res.Msg.Message)
+}
+
+func removeStory(client pokerv1connect.PlanningPokerServiceClient, id, roomId, storyId string) {
+	res, err := client.RemoveStory(context.Background(), connect.NewRequest(&pokerv1.RemoveStoryRequest{Id: id, RoomId: roomId, StoryId: storyId}))
+	if err != nil {
+		log.Println("failed to remove story.", err)
+		return
+	}
+	println(res.Msg.Message)
+}
+
+func startTimer(client pokerv1connect.PlanningPokerServiceClient, id, roomId, seconds string) {
+	d, err := strconv.Atoi(seconds)
+	if err != nil {
+		log.Println("failed to parse seconds.", err)
+		return
+	}
+	res, err := client.StartTimer(context.Background(), connect.NewRequest(&pokerv1.StartTimerRequest{Id: id, RoomId: roomId, DurationSeconds: int32(d), AutoReveal: true}))
+	if err != nil {
+		log.Println("failed to start timer.", err)
+		return
+	}
+	println(fmt.Sprintf("timer started until %s", res.Msg.Timer.Deadline.AsTime().Local().Format(time.TimeOnly)))
+}
+
+// resolveRoomId 招待コードが指定された場合はルームIDに変換する。招待コードでなければそのままルームIDとして扱う。
+func resolveRoomId(client pokerv1connect.PlanningPokerServiceClient, room string) string {
+	res, err := client.ResolveInviteCode(context.Background(), connect.NewRequest(&pokerv1.ResolveInviteCodeRequest{InviteCode: room}))
+	if err != nil {
+		return room
+	}
+	return res.Msg.RoomId
+}
+
+// listRooms 公開されているルームを、全てのページを辿って表示する
+func listRooms(client pokerv1connect.PlanningPokerServiceClient) {
+	var token string
+	for {
+		res, err := client.ListRooms(context.Background(), connect.NewRequest(&pokerv1.ListRoomsRequest{PageToken: token}))
+		if err != nil {
+			log.Fatal("failed to list rooms.", err)
+		}
+		for _, room := range res.Msg.Rooms {
+			line := fmt.Sprintf("%s: %d participants", room.RoomId, len(room.Participants))
+			if room.CurrentStory != nil {
+				line += " estimating " + room.CurrentStory.Title
+			}
+			println(line)
+		}
+		token = res.Msg.NextPageToken
+		if token == "" {
+			return
+		}
+	}
+}
+
+func leaveRoom(client pokerv1connect.PlanningPokerServiceClient, id, roomId string) {
+	if _, err := client.LeaveRoom(context.Background(), connect.NewRequest(&pokerv1.LeaveRoomRequest{Id: id, RoomId: roomId})); err != nil {
+		log.Println("failed to leave room.", err)
+	}
+}
+
+func kickParticipant(client pokerv1connect.PlanningPokerServiceClient, id, roomId, participant string) {
+	res, err := client.KickParticipant(context.Background(), connect.NewRequest(&pokerv1.KickParticipantRequest{Id: id, RoomId: roomId, ParticipantId: participant}))
+	if err != nil {
+		log.Println("failed to kick participant.", err)
+		return
+	}
+	println(res.Msg.Message)
+}
+
+func closeRoom(client pokerv1connect.PlanningPokerServiceClient, id, roomId string) {
+	if _, err := client.CloseRoom(context.Background(), connect.NewRequest(&pokerv1.CloseRoomRequest{Id: id, RoomId: roomId})); err != nil {
+		log.Println("failed to close room.", err)
+	}
+}
+
+func setRoomLock(client pokerv1connect.PlanningPokerServiceClient, id, roomId string, locked bool) {
+	if _, err := client.SetRoomLock(context.Background(), connect.NewRequest(&pokerv1.SetRoomLockRequest{Id: id, RoomId: roomId, Locked: locked})); err != nil {
+		log.Println("failed to lock or unlock room.", err)
+	}
+}
+
+func transferFacilitator(client pokerv1connect.PlanningPokerServiceClient, id, roomId, participant string) {
+	res, err := client.TransferFacilitator(context.Background(), connect.NewRequest(&pokerv1.TransferFacilitatorRequest{Id: id, RoomId: roomId, ParticipantId: participant}))
+	if err != nil {
+		log.Println("failed to hand over facilitator.", err)
+		return
+	}
+	println(res.Msg.Message)
+}
+
+func shareFacilitator(client pokerv1connect.PlanningPokerServiceClient, id, roomId, participant string) {
+	res, err := client.SetRole(context.Background(), connect.NewRequest(&pokerv1.SetRoleRequest{Id: id, RoomId: roomId, ParticipantId: participant, Role: pokerv1.Role_ROLE_FACILITATOR}))
+	if err != nil {
+		log.Println("failed to share facilitator.", err)
+		return
+	}
+	println(res.Msg.Message)
+}
+
+var presetDecks = map[string]pokerv1.DeckPreset{
+	"fibonacci":          pokerv1.DeckPreset_DECK_PRESET_FIBONACCI,
+	"modified-fibonacci": pokerv1.DeckPreset_DECK_PRESET_MODIFIED_FIBONACCI,
+	"t-shirt":            pokerv1.DeckPreset_DECK_PRESET_T_SHIRT,
+	"powers-of-two":      pokerv1.DeckPreset_DECK_PRESET_POWERS_OF_TWO,
+}
+
+// parseDeck -deckで指定されたデッキを解釈する。
+// プリセット名でなければカンマ区切りのカードのラベルとみなし、数値として読めるラベルにはその値を持たせる。
+func parseDeck(s string) (*pokerv1.Deck, error) {
+	if preset, ok := presetDecks[s]; ok {
+		return &pokerv1.Deck{Preset: preset}, nil
+	}
+
+	var cards []*pokerv1.Card
+	for _, label := range strings.Split(s, ",") {
+		label = strings.TrimSpace(label)
+		if label == "" {
+			continue
+		}
+		card := &pokerv1.Card{Label: label}
+		if v, err := strconv.ParseFloat(label, 64); err == nil {
+			card.Value = &v
+		}
+		cards = append(cards, card)
+	}
+	if len(cards) == 0 {
+		return nil, fmt.Errorf("no cards in %q", s)
+	}
+	return &pokerv1.Deck{Preset: pokerv1.DeckPreset_DECK_PRESET_CUSTOM, Cards: cards}, nil
+}
+
 func showVotes(client pokerv1connect.PlanningPokerServiceClient, id, roomId string) {
 	res, err := client.ShowVotes(context.Background(), connect.NewRequest(&pokerv1.ShowVotesRequest{Id: id, RoomId: roomId}))
 	if err != nil {
@@ -173,47 +509,129 @@ func newGame(client pokerv1connect.PlanningPokerServiceClient, id, roomId string
 }
 
 func printlnBroadcastMessage(message *pokerv1.ConnectResponse) {
-	switch message.Type {
-	case pokerv1.MessageType_MESSAGE_TYPE_JOIN:
-		println(color.HiGreenString(message.Message + " joined"))
-	case pokerv1.MessageType_MESSAGE_TYPE_VOTE:
-		println(color.HiGreenString(message.Message + " voted"))
-	case pokerv1.MessageType_MESSAGE_TYPE_SHOW_VOTES:
-		// message.MessageがJSON形式の文字列になっているので、パースして表示する
-		var votes map[string]float32
-		err := json.Unmarshal([]byte(message.Message), &votes)
-		if err != nil {
-			log.Println("failed to unmarshal message.", err)
-		}
+	switch e := message.Event.(type) {
+	case *pokerv1.ConnectResponse_ParticipantJoined:
+		println(color.HiGreenString(e.ParticipantJoined.ParticipantId + " joined as " + roleName(e.ParticipantJoined.Role)))
+	case *pokerv1.ConnectResponse_VoteCast:
+		println(color.HiGreenString(e.VoteCast.ParticipantId + " voted"))
+	case *pokerv1.ConnectResponse_VotesRevealed:
 		println(color.HiGreenString("result"))
-
-		avg := votes[AVERAGE]
-		delete(votes, AVERAGE)
-		for k, v := range votes {
-			println(color.HiGreenString(fmt.Sprintf("%s: %.2f", k, v)))
-		}
-		if len(votes) > 0 {
-			println(color.HiGreenString(fmt.Sprintf("average: %.2f", avg)))
-		}
-	case pokerv1.MessageType_MESSAGE_TYPE_LEAVE:
-		println(color.HiGreenString(message.Message + " left"))
-	case pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM:
-		println(color.CyanString("room " + message.Message + " created"))
-		roomId = message.Message
-	case pokerv1.MessageType_MESSAGE_TYPE_STATUS:
-		// ユーザの状態情報を持つmessage.MessageがJSON形式の文字列になっているので、パースして表示する
-		var status map[string]bool
-		err := json.Unmarshal([]byte(message.Message), &status)
-		if err != nil {
-			log.Println("failed to unmarshal message.", err)
+		for _, v := range e.VotesRevealed.Votes {
+			println(color.HiGreenString(fmt.Sprintf("%s: %s", v.ParticipantId, v.Card)))
 		}
+		if stats := e.VotesRevealed.Statistics; stats != nil {
+			printlnStatistics(stats)
+		}
+	case *pokerv1.ConnectResponse_ParticipantLeft:
+		switch e.ParticipantLeft.Reason {
+		case pokerv1.LeaveReason_LEAVE_REASON_SLOW_CONSUMER:
+			println(color.HiGreenString(e.ParticipantLeft.ParticipantId + " left because the connection was too slow"))
+		case pokerv1.LeaveReason_LEAVE_REASON_KICKED:
+			println(color.HiGreenString(e.ParticipantLeft.ParticipantId + " was kicked by the facilitator"))
+		default:
+			println(color.HiGreenString(e.ParticipantLeft.ParticipantId + " left"))
+		}
+	case *pokerv1.ConnectResponse_RoomCreated:
+		println(color.CyanString("room " + e.RoomCreated.RoomId + " created"))
+		println(color.CyanString("invite code: " + e.RoomCreated.InviteCode))
+		roomId = e.RoomCreated.RoomId
+	case *pokerv1.ConnectResponse_RoomStatus:
 		println(color.CyanString("room status"))
-		for k, v := range status {
-			println(color.CyanString(fmt.Sprintf("%s: %t", k, v)))
+		for _, p := range e.RoomStatus.Participants {
+			println(color.CyanString(fmt.Sprintf("%s(%s): %t", p.ParticipantId, roleName(p.Role), p.Voted)))
+		}
+		if deck := e.RoomStatus.Deck; deck != nil {
+			labels := make([]string, 0, len(deck.Cards))
+			for _, c := range deck.Cards {
+				labels = append(labels, c.Label)
+			}
+			println(color.CyanString("cards: " + strings.Join(labels, " ")))
+		}
+		printlnStories(e.RoomStatus.Stories, e.RoomStatus.CurrentStoryId)
+		if timer := e.RoomStatus.Timer; timer != nil {
+			println(color.YellowString(fmt.Sprintf("%d seconds left", timer.RemainingSeconds)))
+		}
+	case *pokerv1.ConnectResponse_RoundStarted:
+		println(color.YellowString(e.RoundStarted.Message))
+	case *pokerv1.ConnectResponse_VoteReset:
+		println(color.HiGreenString(e.VoteReset.ParticipantId + " reset their vote"))
+	case *pokerv1.ConnectResponse_RoleChanged:
+		println(color.CyanString(e.RoleChanged.ParticipantId + " is now " + roleName(e.RoleChanged.Role)))
+	case *pokerv1.ConnectResponse_StoriesChanged:
+		printlnStories(e.StoriesChanged.Stories, e.StoriesChanged.CurrentStoryId)
+	case *pokerv1.ConnectResponse_TimerStarted:
+		println(color.YellowString(fmt.Sprintf("timer started: %d seconds left", e.TimerStarted.Timer.RemainingSeconds)))
+	case *pokerv1.ConnectResponse_TimerTick:
+		// 残り時間が切りの良い時だけ表示する
+		if r := e.TimerTick.RemainingSeconds; r <= 5 || r%10 == 0 {
+			println(color.YellowString(fmt.Sprintf("%d seconds left", r)))
+		}
+	case *pokerv1.ConnectResponse_TimerExpired:
+		println(color.YellowString("time is up"))
+	case *pokerv1.ConnectResponse_RoomClosed:
+		if e.RoomClosed.ClosedBy == "" {
+			println(color.CyanString("room was closed by the server"))
+			break
+		}
+		println(color.CyanString("room was closed by " + e.RoomClosed.ClosedBy))
+	case *pokerv1.ConnectResponse_RoomLockChanged:
+		if e.RoomLockChanged.Locked {
+			println(color.CyanString("room was locked by " + e.RoomLockChanged.ChangedBy))
+			break
+		}
+		println(color.CyanString("room was unlocked by " + e.RoomLockChanged.ChangedBy))
+	case *pokerv1.ConnectResponse_SettingsChanged:
+		println(color.CyanString(fmt.Sprintf("auto reveal: %t", e.SettingsChanged.Settings.AutoReveal)))
+	case *pokerv1.ConnectResponse_ServerShuttingDown:
+		println(color.YellowString("server is shutting down. reconnecting after it restarts"))
+	}
+}
+
+// printlnStories ストーリーの一覧を表示する。現在のストーリーには*を付ける。
+func printlnStories(stories []*pokerv1.Story, current string) {
+	if len(stories) == 0 {
+		return
+	}
+	println(color.CyanString("stories"))
+	for _, s := range stories {
+		mark := " "
+		if s.Id == current {
+			mark = "*"
+		}
+		line := fmt.Sprintf("%s %s %s", mark, s.Id, s.Title)
+		if s.Estimate != "" {
+			line += " (" + s.Estimate + ")"
 		}
-	case pokerv1.MessageType_MESSAGE_TYPE_NEW_GAME:
-		println(color.YellowString(message.Message))
-	case pokerv1.MessageType_MESSAGE_TYPE_RESET_VOTE:
-		println(color.HiGreenString(message.Message + " reset their vote"))
+		println(color.CyanString(line))
+	}
+}
+
+func roleName(role pokerv1.Role) string {
+	switch role {
+	case pokerv1.Role_ROLE_FACILITATOR:
+		return "facilitator"
+	case pokerv1.Role_ROLE_OBSERVER:
+		return "observer"
+	default:
+		return "voter"
+	}
+}
+
+func printlnStatistics(stats *pokerv1.VoteStatistics) {
+	if stats.Count > 0 {
+		println(color.HiGreenString(fmt.Sprintf("average: %.2f", stats.Average)))
+		println(color.HiGreenString(fmt.Sprintf("median: %.2f", stats.Median)))
+		println(color.HiGreenString(fmt.Sprintf("min: %.2f, max: %.2f", stats.Min, stats.Max)))
+		println(color.HiGreenString(fmt.Sprintf("standard deviation: %.2f", stats.StandardDeviation)))
+	}
+	if len(stats.Modes) > 0 {
+		println(color.HiGreenString("mode: " + strings.Join(stats.Modes, ", ")))
+		println(color.HiGreenString(fmt.Sprintf("spread: %d", stats.Spread)))
+	}
+	if stats.Consensus {
+		println(color.HiCyanString("consensus reached!"))
+	} else if stats.Spread > 0 {
+		println(color.YellowString("lowest: " + strings.Join(stats.LowestVoters, ", ")))
+		println(color.YellowString("highest: " + strings.Join(stats.HighestVoters, ", ")))
 	}
 }
@@ -3,6 +3,8 @@ package main
 import (
 	"sync"
 	"testing"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
 )
 
 type A struct {
@@ -19,3 +21,31 @@ func TestA(t *testing.T) {
 		return true
 	})
 }
+
+func TestParseDeck(t *testing.T) {
+	deck, err := parseDeck("t-shirt")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deck.Preset != pokerv1.DeckPreset_DECK_PRESET_T_SHIRT || len(deck.Cards) != 0 {
+		t.Fatalf("unexpected deck %v", deck)
+	}
+
+	deck, err = parseDeck("0, 0.5, 1, ?")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deck.Preset != pokerv1.DeckPreset_DECK_PRESET_CUSTOM || len(deck.Cards) != 4 {
+		t.Fatalf("unexpected deck %v", deck)
+	}
+	if deck.Cards[1].Label != "0.5" || deck.Cards[1].GetValue() != 0.5 {
+		t.Fatalf("unexpected card %v", deck.Cards[1])
+	}
+	if deck.Cards[3].Label != "?" || deck.Cards[3].Value != nil {
+		t.Fatalf("unexpected card %v", deck.Cards[3])
+	}
+
+	if _, err := parseDeck(" , "); err == nil {
+		t.Fatal("expected error for empty deck")
+	}
+}
@@ -4,6 +4,8 @@ import { StartNewGameConfig } from '@/app/types/newGame';
 type JoinRoomFormData = {
   roomId: string;
   userName: string;
+  observer: boolean;
+  passphrase: string;
 }
 
 type JoinRoomFormProps = {
@@ -14,14 +16,14 @@ export default function JoinRoomForm({ connectRoom }: JoinRoomFormProps) {
   const { register, handleSubmit } = useForm<JoinRoomFormData>();
 
   const onSubmit = (data: JoinRoomFormData) => {
-    connectRoom({room: data.roomId, userName: data.userName});
+    connectRoom({room: data.roomId, userName: data.userName, observer: data.observer, passphrase: data.passphrase});
   };
 
   return (
     <form className='space-y-6' action='#' method='POST' onSubmit={handleSubmit(onSubmit)}>
       <div>
         <label htmlFor='roomId' className='block text-sm font-medium leading-6 text-gray-900'>
-          ルームID
+          ルームIDまたは招待コード
         </label>
         <div className='mt-2'>
           <input
@@ -44,6 +46,26 @@ export default function JoinRoomForm({ connectRoom }: JoinRoomFormProps) {
           />
         </div>
       </div>
+      <div>
+        <label htmlFor='passphrase' className='block text-sm font-medium leading-6 text-gray-900'>
+          合言葉
+        </label>
+        <div className='mt-2'>
+          <input
+            id='passphrase'
+            type='password'
+            placeholder='合言葉のないルームでは空欄'
+            className='block w-full rounded-md border-0 px-3 py-1.5 text-gray-900 shadow-sm ring-1 ring-inset ring-gray-300 placeholder:text-gray-400 focus:ring-2 focus:ring-inset focus:ring-indigo-600 sm:text-sm sm:leading-6'
+            {...register('passphrase')}
+          />
+        </div>
+      </div>
+      <div className='flex items-center gap-2'>
+        <input id='observer' type='checkbox' {...register('observer')} />
+        <label htmlFor='observer' className='text-sm font-medium leading-6 text-gray-900'>
+          オブザーバーとして参加（投票しない）
+        </label>
+      </div>
       <button
         type='submit'
         className='flex w-full justify-center rounded-md bg-indigo-600 px-3 py-1.5 text-sm font-semibold leading-6 text-white shadow-sm hover:bg-indigo-500 focus-visible:outline focus-visible:outline-2 focus-visible:outline-offset-2 focus-visible:outline-indigo-600'
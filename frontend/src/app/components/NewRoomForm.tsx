@@ -4,6 +4,7 @@ import { StartNewGameConfig } from '@/app/types/newGame';
 type NewRoomFormData = {
   roomId: string;
   userName: string;
+  passphrase: string;
 }
 
 type NewRoomFormProps = {
@@ -14,7 +15,7 @@ export default function NewRoomForm({ connectRoom }: NewRoomFormProps) {
   const { register, handleSubmit } = useForm<NewRoomFormData>();
 
   const onSubmit = (data: NewRoomFormData) => {
-    connectRoom({room: data.roomId, userName: data.userName})
+    connectRoom({room: data.roomId, userName: data.userName, passphrase: data.passphrase})
   };
 
   return (
@@ -26,7 +27,7 @@ export default function NewRoomForm({ connectRoom }: NewRoomFormProps) {
         <div className='mt-2'>
           <input
             id='roomId'
-            required
+            placeholder='空欄の場合は自動で生成します'
             className='block w-full rounded-md border-0 px-3 py-1.5 text-gray-900 shadow-sm ring-1 ring-inset ring-gray-300 placeholder:text-gray-400 focus:ring-2 focus:ring-inset focus:ring-indigo-600 sm:text-sm sm:leading-6'
             {...register('roomId')} />
         </div>
@@ -44,6 +45,20 @@ export default function NewRoomForm({ connectRoom }: NewRoomFormProps) {
           />
         </div>
       </div>
+      <div>
+        <label htmlFor='passphrase' className='block text-sm font-medium leading-6 text-gray-900'>
+          合言葉
+        </label>
+        <div className='mt-2'>
+          <input
+            id='passphrase'
+            type='password'
+            placeholder='空欄の場合は誰でも参加できます'
+            className='block w-full rounded-md border-0 px-3 py-1.5 text-gray-900 shadow-sm ring-1 ring-inset ring-gray-300 placeholder:text-gray-400 focus:ring-2 focus:ring-inset focus:ring-indigo-600 sm:text-sm sm:leading-6'
+            {...register('passphrase')}
+          />
+        </div>
+      </div>
       <button
         type='submit'
         className='flex w-full justify-center rounded-md bg-indigo-600 px-3 py-1.5 text-sm font-semibold leading-6 text-white shadow-sm hover:bg-indigo-500 focus-visible:outline focus-visible:outline-2 focus-visible:outline-offset-2 focus-visible:outline-indigo-600'
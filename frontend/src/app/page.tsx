@@ -1,6 +1,6 @@
 'use client';
 import AsyncLock from 'async-lock';
-import React, { useState } from 'react';
+import React, { useRef, useState } from 'react';
 import { createPromiseClient} from "@connectrpc/connect";
 import { createConnectTransport } from "@connectrpc/connect-web";
 
@@ -19,7 +19,9 @@ import {
   VoteRequest,
   ShowVotesRequest,
   NewGameRequest,
-  MessageType
+  ResolveInviteCodeRequest,
+  MessageType,
+  Role
 } from "@/gen/proto/v1/planning_poker_pb";
 
 
@@ -27,6 +29,8 @@ import {
 const FIBONACCI = [1, 2, 3, 5, 8, 13, 21];
 const VOTE_RESET_NUMBER = -1;
 const AVERAGE_KEY_NAME = "average";
+// サーバから発行されたセッショントークンをやり取りするヘッダ
+const SESSION_HEADER = "Planning-Poker-Session";
 
 if(process.env.NEXT_PUBLIC_SERVER === undefined) {
   throw new Error('SERVER is not defined');
@@ -47,11 +51,20 @@ export default function Home() {
   const [votedNumber, setVotedNumber] = useState<number | null>(null);
   const [isShown, setIsShown] = useState<boolean>(false);
   const [average, setAverage] = useState<number>(0);
+  const [story, setStory] = useState<string>('');
+  const [remaining, setRemaining] = useState<number | null>(null);
+  const [inviteCode, setInviteCode] = useState<string>('');
+  const sessionToken = useRef<string>('');
+
+  const onHeader = (headers: Headers) => {
+    sessionToken.current = headers.get(SESSION_HEADER) ?? '';
+  };
+  const withSession = () => ({headers: {[SESSION_HEADER]: sessionToken.current}});
 
   const createNewRoom = async (config: StartNewGameConfig) => {
-    const req = new CreateRoomRequest({id: config.userName, roomId: config.room})
+    const req = new CreateRoomRequest({id: config.userName, roomId: config.room, passphrase: config.passphrase ?? ''})
     setName(config.userName);
-    for await (const res of client.createRoom(req) as AsyncIterable<ConnectResponse>) {
+    for await (const res of client.createRoom(req, {onHeader}) as AsyncIterable<ConnectResponse>) {
       try {
         await receiveMessage(res);
         setResponse(res);
@@ -61,11 +74,22 @@ export default function Home() {
     }
   };
 
+  // 招待コードが入力された場合はルームIDに変換する。招待コードでなければそのままルームIDとして扱う
+  const resolveRoomId = async (room: string) => {
+    try {
+      const res = await client.resolveInviteCode(new ResolveInviteCodeRequest({inviteCode: room}));
+      return res.roomId;
+    } catch {
+      return room;
+    }
+  };
+
   const joinRoom = async (config: StartNewGameConfig) => {
-    const req = new ConnectRequest({id: config.userName, roomId: config.room})
+    const room = await resolveRoomId(config.room);
+    const req = new ConnectRequest({id: config.userName, roomId: room, observer: config.observer ?? false, passphrase: config.passphrase ?? ''})
     setName(config.userName);
-    setRoomId(config.room);
-    for await (const res of client.connect(req) as AsyncIterable<ConnectResponse>) {
+    setRoomId(room);
+    for await (const res of client.connect(req, {onHeader}) as AsyncIterable<ConnectResponse>) {
       try {
         await receiveMessage(res);
         setResponse(res);
@@ -82,7 +106,7 @@ export default function Home() {
     const req = new VoteRequest({id: name, roomId, vote: num});
 
     try {
-      await client.vote(req);
+      await client.vote(req, withSession());
       if(num === VOTE_RESET_NUMBER) {
         setVotedNumber(null);
       } else {
@@ -95,10 +119,10 @@ export default function Home() {
   };
 
   const showVotes = async () => {
-    const req = new ShowVotesRequest({roomId: roomId});
+    const req = new ShowVotesRequest({id: name, roomId: roomId});
 
     try {
-      await client.showVotes(req);
+      await client.showVotes(req, withSession());
     } catch (err) {
       console.error(err);
       alert('投票結果を表示できませんでした。');
@@ -106,10 +130,10 @@ export default function Home() {
   };
 
   const startNewGame = async () => {
-    const req = new NewGameRequest({roomId: roomId});
+    const req = new NewGameRequest({id: name, roomId: roomId});
 
     try {
-      await client.newGame(req);
+      await client.newGame(req, withSession());
     } catch(err) {
       console.error(err);
       alert('新しいゲームを開始できませんでした。');
@@ -122,6 +146,10 @@ export default function Home() {
       switch (res.type) {
         case MessageType.JOIN:
           console.log("join", res.message);
+          // オブザーバーは投票しないので、投票状況には表示しない
+          if (res.event.case === 'participantJoined' && res.event.value.role === Role.OBSERVER) {
+            break;
+          }
           players[res.message] = {name: res.message, isVoted: false, vote: null};
           setPlayers(players);
           break;
@@ -141,6 +169,7 @@ export default function Home() {
           for (const [key, value] of Object.entries(votes)) {
             if (key === AVERAGE_KEY_NAME) {
               setAverage(value);
+              setRemaining(null);
               continue;
             }
 
@@ -165,10 +194,14 @@ export default function Home() {
           setPlayers(players);
           setVotedNumber(null);
           setIsShown(false);
+          setRemaining(null);
           break;
         case MessageType.CREATE_ROOM:
           console.log("create room", res.message);
           setRoomId(res.message);
+          if (res.event.case === 'roomCreated') {
+            setInviteCode(res.event.value.inviteCode);
+          }
           break;
         case MessageType.STATUS:
           console.log("status", res.message);
@@ -178,6 +211,33 @@ export default function Home() {
           }
           setPlayers(players);
           console.log(players);
+          if (res.event.case === 'roomStatus') {
+            const status = res.event.value;
+            setStory(status.stories.find((s) => s.id === status.currentStoryId)?.title ?? '');
+            setRemaining(status.timer?.remainingSeconds ?? null);
+            setInviteCode(status.inviteCode);
+          }
+          break;
+        case MessageType.TIMER_STARTED:
+        case MessageType.TIMER_TICK:
+          setRemaining(Number(res.message));
+          break;
+        case MessageType.TIMER_EXPIRED:
+          setRemaining(null);
+          break;
+        case MessageType.ROOM_CLOSED:
+          console.log("room closed", res.message);
+          alert('ルームが閉じられました。');
+          setPlayers({});
+          setRoomId('');
+          break;
+        case MessageType.SERVER_SHUTTING_DOWN:
+          console.log("server shutting down", res.message);
+          alert('サーバが停止します。再起動した後に、もう一度参加してください。');
+          break;
+        case MessageType.STORIES_CHANGED:
+          console.log("stories changed", res.message);
+          setStory(res.message);
           break;
         case MessageType.RESET_VOTE:
           console.log("reset vote", res.message);
@@ -203,6 +263,9 @@ export default function Home() {
         <div className='mb-4'>
           <h2 className='text-2xl font-bold leading-9 text-gray-900'>ルームID: {roomId}</h2>
           <p>ユーザ名: {name}</p>
+          {inviteCode && <p>招待コード: {inviteCode}</p>}
+          {story && <p>ストーリー: {story}</p>}
+          {remaining !== null && <p>残り時間: {remaining}秒</p>}
         </div>
         <div className='mb-4 flex flex-wrap'>
           {
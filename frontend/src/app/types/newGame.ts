@@ -1,4 +1,6 @@
 export type StartNewGameConfig = {
   room: string; // room名かroomIDを入れる(暫定的)
   userName: string;
+  observer?: boolean; // 投票せずに見るだけの参加者として参加する
+  passphrase?: string; // ルームの合言葉。合言葉のないルームでは空
 }
@@ -3,7 +3,7 @@
 /* eslint-disable */
 // @ts-nocheck
 
-import { ConnectRequest, ConnectResponse, CreateRoomRequest, NewGameRequest, NewGameResponse, ShowVotesRequest, ShowVotesResponse, VoteRequest, VoteResponse } from "./planning_poker_pb.ts";
+import { AcceptEstimateRequest, AcceptEstimateResponse, AddStoryRequest, AddStoryResponse, CloseRoomRequest, CloseRoomResponse, ConnectRequest, ConnectResponse, CreateRoomRequest, GetRoomHistoryRequest, GetRoomHistoryResponse, GetRoomRequest, GetRoomResponse, KickParticipantRequest, KickParticipantResponse, LeaveRoomRequest, LeaveRoomResponse, ListRoomsRequest, ListRoomsResponse, NewGameRequest, NewGameResponse, RemoveStoryRequest, RemoveStoryResponse, ReorderStoriesRequest, ReorderStoriesResponse, ResolveInviteCodeRequest, ResolveInviteCodeResponse, SelectStoryRequest, SelectStoryResponse, SetRoleRequest, SetRoleResponse, SetRoomLockRequest, SetRoomLockResponse, ShowVotesRequest, ShowVotesResponse, StartTimerRequest, StartTimerResponse, TransferFacilitatorRequest, TransferFacilitatorResponse, UpdateSettingsRequest, UpdateSettingsResponse, VoteRequest, VoteResponse } from "./planning_poker_pb.ts";
 import { MethodKind } from "@bufbuild/protobuf";
 
 /**
@@ -57,6 +57,198 @@ export const PlanningPokerService = {
       O: NewGameResponse,
       kind: MethodKind.Unary,
     },
+    /**
+     * Hands the facilitator role of the caller over to another participant.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.TransferFacilitator
+     */
+    transferFacilitator: {
+      name: "TransferFacilitator",
+      I: TransferFacilitatorRequest,
+      O: TransferFacilitatorResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Changes the role of a participant. Used to share the facilitator role.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.SetRole
+     */
+    setRole: {
+      name: "SetRole",
+      I: SetRoleRequest,
+      O: SetRoleResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Story backlog of the room. Only the facilitator can change it.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.AddStory
+     */
+    addStory: {
+      name: "AddStory",
+      I: AddStoryRequest,
+      O: AddStoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Reorders the backlog. story_ids must contain every story of the room.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.ReorderStories
+     */
+    reorderStories: {
+      name: "ReorderStories",
+      I: ReorderStoriesRequest,
+      O: ReorderStoriesResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * @generated from rpc proto.v1.PlanningPokerService.RemoveStory
+     */
+    removeStory: {
+      name: "RemoveStory",
+      I: RemoveStoryRequest,
+      O: RemoveStoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Makes the story the one being estimated in the current round.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.SelectStory
+     */
+    selectStory: {
+      name: "SelectStory",
+      I: SelectStoryRequest,
+      O: SelectStoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Records the final estimate agreed on for a story.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.AcceptEstimate
+     */
+    acceptEstimate: {
+      name: "AcceptEstimate",
+      I: AcceptEstimateRequest,
+      O: AcceptEstimateResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the revealed rounds of the room in the order they were played.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.GetRoomHistory
+     */
+    getRoomHistory: {
+      name: "GetRoomHistory",
+      I: GetRoomHistoryRequest,
+      O: GetRoomHistoryResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Starts a countdown for the current round. Only the facilitator can start it.
+     * Starting a timer while another one is running replaces it.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.StartTimer
+     */
+    startTimer: {
+      name: "StartTimer",
+      I: StartTimerRequest,
+      O: StartTimerResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Changes the settings of the room listed in update_mask. Only the
+     * facilitator can change them.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.UpdateSettings
+     */
+    updateSettings: {
+      name: "UpdateSettings",
+      I: UpdateSettingsRequest,
+      O: UpdateSettingsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Leaves the room and ends the Connect stream of the caller.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.LeaveRoom
+     */
+    leaveRoom: {
+      name: "LeaveRoom",
+      I: LeaveRoomRequest,
+      O: LeaveRoomResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Removes a participant from the room and clears their vote. Only the
+     * facilitator can kick participants, e.g. ones who left without closing
+     * their stream.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.KickParticipant
+     */
+    kickParticipant: {
+      name: "KickParticipant",
+      I: KickParticipantRequest,
+      O: KickParticipantResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Closes the room for everyone. Only the facilitator can close it.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.CloseRoom
+     */
+    closeRoom: {
+      name: "CloseRoom",
+      I: CloseRoomRequest,
+      O: CloseRoomResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the current state of a room without joining it. Participants and
+     * the current story of a room with a passphrase are left out unless the
+     * caller is a participant of the room.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.GetRoom
+     */
+    getRoom: {
+      name: "GetRoom",
+      I: GetRoomRequest,
+      O: GetRoomResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Lists the public rooms ordered by their IDs.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.ListRooms
+     */
+    listRooms: {
+      name: "ListRooms",
+      I: ListRoomsRequest,
+      O: ListRoomsResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Returns the ID of the room an invite code was issued for.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.ResolveInviteCode
+     */
+    resolveInviteCode: {
+      name: "ResolveInviteCode",
+      I: ResolveInviteCodeRequest,
+      O: ResolveInviteCodeResponse,
+      kind: MethodKind.Unary,
+    },
+    /**
+     * Locks or unlocks the room. A locked room refuses new participants.
+     * Only the facilitator can change it.
+     *
+     * @generated from rpc proto.v1.PlanningPokerService.SetRoomLock
+     */
+    setRoomLock: {
+      name: "SetRoomLock",
+      I: SetRoomLockRequest,
+      O: SetRoomLockResponse,
+      kind: MethodKind.Unary,
+    },
   }
 } as const;
 
@@ -4,7 +4,7 @@
 // @ts-nocheck
 
 import type { BinaryReadOptions, FieldList, JsonReadOptions, JsonValue, PartialMessage, PlainMessage } from "@bufbuild/protobuf";
-import { Message, proto3 } from "@bufbuild/protobuf";
+import { Message, proto3, protoInt64, FieldMask, Timestamp } from "@bufbuild/protobuf";
 
 /**
  * @generated from enum proto.v1.MessageType
@@ -54,24 +54,3165 @@ export enum MessageType {
    * @generated from enum value: MESSAGE_TYPE_RESET_VOTE = 8;
    */
   RESET_VOTE = 8,
+
+  /**
+   * @generated from enum value: MESSAGE_TYPE_ROLE_CHANGED = 9;
+   */
+  ROLE_CHANGED = 9,
+
+  /**
+   * @generated from enum value: MESSAGE_TYPE_STORIES_CHANGED = 10;
+   */
+  STORIES_CHANGED = 10,
+
+  /**
+   * @generated from enum value: MESSAGE_TYPE_TIMER_STARTED = 11;
+   */
+  TIMER_STARTED = 11,
+
+  /**
+   * @generated from enum value: MESSAGE_TYPE_TIMER_TICK = 12;
+   */
+  TIMER_TICK = 12,
+
+  /**
+   * @generated from enum value: MESSAGE_TYPE_TIMER_EXPIRED = 13;
+   */
+  TIMER_EXPIRED = 13,
+
+  /**
+   * @generated from enum value: MESSAGE_TYPE_SETTINGS_CHANGED = 14;
+   */
+  SETTINGS_CHANGED = 14,
+
+  /**
+   * @generated from enum value: MESSAGE_TYPE_ROOM_CLOSED = 15;
+   */
+  ROOM_CLOSED = 15,
+
+  /**
+   * @generated from enum value: MESSAGE_TYPE_LOCK_CHANGED = 16;
+   */
+  LOCK_CHANGED = 16,
+
+  /**
+   * @generated from enum value: MESSAGE_TYPE_SERVER_SHUTTING_DOWN = 17;
+   */
+  SERVER_SHUTTING_DOWN = 17,
+}
+// Retrieve enum metadata with: proto3.getEnumType(MessageType)
+proto3.util.setEnumType(MessageType, "proto.v1.MessageType", [
+  { no: 0, name: "MESSAGE_TYPE_UNSPECIFIED" },
+  { no: 1, name: "MESSAGE_TYPE_JOIN" },
+  { no: 2, name: "MESSAGE_TYPE_VOTE" },
+  { no: 3, name: "MESSAGE_TYPE_SHOW_VOTES" },
+  { no: 4, name: "MESSAGE_TYPE_LEAVE" },
+  { no: 5, name: "MESSAGE_TYPE_NEW_GAME" },
+  { no: 6, name: "MESSAGE_TYPE_CREATE_ROOM" },
+  { no: 7, name: "MESSAGE_TYPE_STATUS" },
+  { no: 8, name: "MESSAGE_TYPE_RESET_VOTE" },
+  { no: 9, name: "MESSAGE_TYPE_ROLE_CHANGED" },
+  { no: 10, name: "MESSAGE_TYPE_STORIES_CHANGED" },
+  { no: 11, name: "MESSAGE_TYPE_TIMER_STARTED" },
+  { no: 12, name: "MESSAGE_TYPE_TIMER_TICK" },
+  { no: 13, name: "MESSAGE_TYPE_TIMER_EXPIRED" },
+  { no: 14, name: "MESSAGE_TYPE_SETTINGS_CHANGED" },
+  { no: 15, name: "MESSAGE_TYPE_ROOM_CLOSED" },
+  { no: 16, name: "MESSAGE_TYPE_LOCK_CHANGED" },
+  { no: 17, name: "MESSAGE_TYPE_SERVER_SHUTTING_DOWN" },
+]);
+
+/**
+ * @generated from enum proto.v1.Role
+ */
+export enum Role {
+  /**
+   * @generated from enum value: ROLE_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * Can reveal votes, start a new game and change roles, in addition to voting.
+   *
+   * @generated from enum value: ROLE_FACILITATOR = 1;
+   */
+  FACILITATOR = 1,
+
+  /**
+   * @generated from enum value: ROLE_VOTER = 2;
+   */
+  VOTER = 2,
+
+  /**
+   * @generated from enum value: ROLE_OBSERVER = 3;
+   */
+  OBSERVER = 3,
+}
+// Retrieve enum metadata with: proto3.getEnumType(Role)
+proto3.util.setEnumType(Role, "proto.v1.Role", [
+  { no: 0, name: "ROLE_UNSPECIFIED" },
+  { no: 1, name: "ROLE_FACILITATOR" },
+  { no: 2, name: "ROLE_VOTER" },
+  { no: 3, name: "ROLE_OBSERVER" },
+]);
+
+/**
+ * @generated from enum proto.v1.LeaveReason
+ */
+export enum LeaveReason {
+  /**
+   * @generated from enum value: LEAVE_REASON_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * The participant closed the stream.
+   *
+   * @generated from enum value: LEAVE_REASON_DISCONNECTED = 1;
+   */
+  DISCONNECTED = 1,
+
+  /**
+   * The server disconnected the participant because they could not keep up with the events.
+   *
+   * @generated from enum value: LEAVE_REASON_SLOW_CONSUMER = 2;
+   */
+  SLOW_CONSUMER = 2,
+
+  /**
+   * The participant called LeaveRoom.
+   *
+   * @generated from enum value: LEAVE_REASON_LEFT = 3;
+   */
+  LEFT = 3,
+
+  /**
+   * The facilitator kicked the participant.
+   *
+   * @generated from enum value: LEAVE_REASON_KICKED = 4;
+   */
+  KICKED = 4,
+}
+// Retrieve enum metadata with: proto3.getEnumType(LeaveReason)
+proto3.util.setEnumType(LeaveReason, "proto.v1.LeaveReason", [
+  { no: 0, name: "LEAVE_REASON_UNSPECIFIED" },
+  { no: 1, name: "LEAVE_REASON_DISCONNECTED" },
+  { no: 2, name: "LEAVE_REASON_SLOW_CONSUMER" },
+  { no: 3, name: "LEAVE_REASON_LEFT" },
+  { no: 4, name: "LEAVE_REASON_KICKED" },
+]);
+
+/**
+ * @generated from enum proto.v1.DeckPreset
+ */
+export enum DeckPreset {
+  /**
+   * @generated from enum value: DECK_PRESET_UNSPECIFIED = 0;
+   */
+  UNSPECIFIED = 0,
+
+  /**
+   * @generated from enum value: DECK_PRESET_FIBONACCI = 1;
+   */
+  FIBONACCI = 1,
+
+  /**
+   * @generated from enum value: DECK_PRESET_MODIFIED_FIBONACCI = 2;
+   */
+  MODIFIED_FIBONACCI = 2,
+
+  /**
+   * @generated from enum value: DECK_PRESET_T_SHIRT = 3;
+   */
+  T_SHIRT = 3,
+
+  /**
+   * @generated from enum value: DECK_PRESET_POWERS_OF_TWO = 4;
+   */
+  POWERS_OF_TWO = 4,
+
+  /**
+   * @generated from enum value: DECK_PRESET_CUSTOM = 5;
+   */
+  CUSTOM = 5,
+}
+// Retrieve enum metadata with: proto3.getEnumType(DeckPreset)
+proto3.util.setEnumType(DeckPreset, "proto.v1.DeckPreset", [
+  { no: 0, name: "DECK_PRESET_UNSPECIFIED" },
+  { no: 1, name: "DECK_PRESET_FIBONACCI" },
+  { no: 2, name: "DECK_PRESET_MODIFIED_FIBONACCI" },
+  { no: 3, name: "DECK_PRESET_T_SHIRT" },
+  { no: 4, name: "DECK_PRESET_POWERS_OF_TWO" },
+  { no: 5, name: "DECK_PRESET_CUSTOM" },
+]);
+
+/**
+ * @generated from message proto.v1.Card
+ */
+export class Card extends Message<Card> {
+  /**
+   * @generated from field: string label = 1;
+   */
+  label = "";
+
+  /**
+   * Cards without a value (e.g. "?" or T-shirt sizes) are revealed but
+   * excluded from the statistics.
+   *
+   * @generated from field: optional double value = 2;
+   */
+  value?: number;
+
+  constructor(data?: PartialMessage<Card>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.Card";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "label", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "value", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Card {
+    return new Card().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Card {
+    return new Card().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Card {
+    return new Card().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Card | PlainMessage<Card> | undefined, b: Card | PlainMessage<Card> | undefined): boolean {
+    return proto3.util.equals(Card, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.Deck
+ */
+export class Deck extends Message<Deck> {
+  /**
+   * @generated from field: proto.v1.DeckPreset preset = 1;
+   */
+  preset = DeckPreset.UNSPECIFIED;
+
+  /**
+   * Required when preset is DECK_PRESET_CUSTOM. Ignored for the other presets
+   * in requests, and always filled in responses.
+   *
+   * @generated from field: repeated proto.v1.Card cards = 2;
+   */
+  cards: Card[] = [];
+
+  constructor(data?: PartialMessage<Deck>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.Deck";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "preset", kind: "enum", T: proto3.getEnumType(DeckPreset) },
+    { no: 2, name: "cards", kind: "message", T: Card, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Deck {
+    return new Deck().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Deck {
+    return new Deck().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Deck {
+    return new Deck().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Deck | PlainMessage<Deck> | undefined, b: Deck | PlainMessage<Deck> | undefined): boolean {
+    return proto3.util.equals(Deck, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.RoomSettings
+ */
+export class RoomSettings extends Message<RoomSettings> {
+  /**
+   * Reveals the votes once every connected participant except observers has
+   * voted, in the same way as ShowVotes.
+   *
+   * @generated from field: bool auto_reveal = 1;
+   */
+  autoReveal = false;
+
+  /**
+   * Seconds to wait before the automatic reveal, so that participants can
+   * still change their votes. Between 0 and 60. The wait starts over when a
+   * vote changes, and the reveal is cancelled when someone resets their vote
+   * or joins the room.
+   *
+   * @generated from field: int32 auto_reveal_delay_seconds = 2;
+   */
+  autoRevealDelaySeconds = 0;
+
+  /**
+   * Lists the room in ListRooms.
+   *
+   * @generated from field: bool public = 3;
+   */
+  public = false;
+
+  constructor(data?: PartialMessage<RoomSettings>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.RoomSettings";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "auto_reveal", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "auto_reveal_delay_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "public", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomSettings {
+    return new RoomSettings().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomSettings {
+    return new RoomSettings().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomSettings {
+    return new RoomSettings().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoomSettings | PlainMessage<RoomSettings> | undefined, b: RoomSettings | PlainMessage<RoomSettings> | undefined): boolean {
+    return proto3.util.equals(RoomSettings, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.CreateRoomRequest
+ */
+export class CreateRoomRequest extends Message<CreateRoomRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * The server generates an unguessable ID when empty. Fails with
+   * ALREADY_EXISTS if a room with the ID exists.
+   *
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  /**
+   * Defaults to the Fibonacci deck.
+   *
+   * @generated from field: proto.v1.Deck deck = 3;
+   */
+  deck?: Deck;
+
+  /**
+   * @generated from field: proto.v1.RoomSettings settings = 4;
+   */
+  settings?: RoomSettings;
+
+  /**
+   * Participants must present it to Connect. The server keeps only its hash.
+   * At most 72 bytes.
+   *
+   * @generated from field: string passphrase = 5;
+   */
+  passphrase = "";
+
+  constructor(data?: PartialMessage<CreateRoomRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.CreateRoomRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "deck", kind: "message", T: Deck },
+    { no: 4, name: "settings", kind: "message", T: RoomSettings },
+    { no: 5, name: "passphrase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateRoomRequest {
+    return new CreateRoomRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateRoomRequest {
+    return new CreateRoomRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateRoomRequest {
+    return new CreateRoomRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: CreateRoomRequest | PlainMessage<CreateRoomRequest> | undefined, b: CreateRoomRequest | PlainMessage<CreateRoomRequest> | undefined): boolean {
+    return proto3.util.equals(CreateRoomRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.ConnectRequest
+ */
+export class ConnectRequest extends Message<ConnectRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  /**
+   * Sequence number of the last event the client received. When set, the
+   * server replays the events broadcast after it and replaces the stale
+   * stream of the same participant instead of rejecting the connection.
+   *
+   * @generated from field: uint64 resume_after = 3;
+   */
+  resumeAfter = protoInt64.zero;
+
+  /**
+   * Joins as an observer, who follows the room without voting. Observers are
+   * excluded from the completion checks and the statistics. Ignored when
+   * resuming.
+   *
+   * @generated from field: bool observer = 4;
+   */
+  observer = false;
+
+  /**
+   * Required when the room has a passphrase. Connect fails with
+   * UNAUTHENTICATED if it does not match, unless the stream is resumed with
+   * the session token. A locked room refuses new participants with
+   * FAILED_PRECONDITION.
+   *
+   * @generated from field: string passphrase = 5;
+   */
+  passphrase = "";
+
+  constructor(data?: PartialMessage<ConnectRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.ConnectRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "resume_after", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "observer", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 5, name: "passphrase", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectRequest {
+    return new ConnectRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectRequest {
+    return new ConnectRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectRequest {
+    return new ConnectRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectRequest | PlainMessage<ConnectRequest> | undefined, b: ConnectRequest | PlainMessage<ConnectRequest> | undefined): boolean {
+    return proto3.util.equals(ConnectRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.ConnectResponse
+ */
+export class ConnectResponse extends Message<ConnectResponse> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: proto.v1.MessageType type = 2;
+   */
+  type = MessageType.UNSPECIFIED;
+
+  /**
+   * Deprecated: use event instead. It is still populated for one release
+   * so that older clients keep working.
+   *
+   * @generated from field: string message = 3 [deprecated = true];
+   * @deprecated
+   */
+  message = "";
+
+  /**
+   * Monotonically increasing number of the event within the room.
+   * Events which are not broadcast to the room (e.g. room_created) carry 0,
+   * and room_status carries the sequence of the last broadcast event.
+   *
+   * @generated from field: uint64 sequence = 12;
+   */
+  sequence = protoInt64.zero;
+
+  /**
+   * @generated from oneof proto.v1.ConnectResponse.event
+   */
+  event: {
+    /**
+     * @generated from field: proto.v1.RoomCreated room_created = 4;
+     */
+    value: RoomCreated;
+    case: "roomCreated";
+  } | {
+    /**
+     * @generated from field: proto.v1.ParticipantJoined participant_joined = 5;
+     */
+    value: ParticipantJoined;
+    case: "participantJoined";
+  } | {
+    /**
+     * @generated from field: proto.v1.ParticipantLeft participant_left = 6;
+     */
+    value: ParticipantLeft;
+    case: "participantLeft";
+  } | {
+    /**
+     * @generated from field: proto.v1.VoteCast vote_cast = 7;
+     */
+    value: VoteCast;
+    case: "voteCast";
+  } | {
+    /**
+     * @generated from field: proto.v1.VoteReset vote_reset = 8;
+     */
+    value: VoteReset;
+    case: "voteReset";
+  } | {
+    /**
+     * @generated from field: proto.v1.VotesRevealed votes_revealed = 9;
+     */
+    value: VotesRevealed;
+    case: "votesRevealed";
+  } | {
+    /**
+     * @generated from field: proto.v1.RoundStarted round_started = 10;
+     */
+    value: RoundStarted;
+    case: "roundStarted";
+  } | {
+    /**
+     * @generated from field: proto.v1.RoomStatus room_status = 11;
+     */
+    value: RoomStatus;
+    case: "roomStatus";
+  } | {
+    /**
+     * @generated from field: proto.v1.RoleChanged role_changed = 13;
+     */
+    value: RoleChanged;
+    case: "roleChanged";
+  } | {
+    /**
+     * @generated from field: proto.v1.StoriesChanged stories_changed = 14;
+     */
+    value: StoriesChanged;
+    case: "storiesChanged";
+  } | {
+    /**
+     * @generated from field: proto.v1.TimerStarted timer_started = 15;
+     */
+    value: TimerStarted;
+    case: "timerStarted";
+  } | {
+    /**
+     * @generated from field: proto.v1.TimerTick timer_tick = 16;
+     */
+    value: TimerTick;
+    case: "timerTick";
+  } | {
+    /**
+     * @generated from field: proto.v1.TimerExpired timer_expired = 17;
+     */
+    value: TimerExpired;
+    case: "timerExpired";
+  } | {
+    /**
+     * @generated from field: proto.v1.SettingsChanged settings_changed = 18;
+     */
+    value: SettingsChanged;
+    case: "settingsChanged";
+  } | {
+    /**
+     * @generated from field: proto.v1.RoomClosed room_closed = 19;
+     */
+    value: RoomClosed;
+    case: "roomClosed";
+  } | {
+    /**
+     * @generated from field: proto.v1.RoomLockChanged room_lock_changed = 20;
+     */
+    value: RoomLockChanged;
+    case: "roomLockChanged";
+  } | {
+    /**
+     * @generated from field: proto.v1.ServerShuttingDown server_shutting_down = 21;
+     */
+    value: ServerShuttingDown;
+    case: "serverShuttingDown";
+  } | { case: undefined; value?: undefined } = { case: undefined };
+
+  constructor(data?: PartialMessage<ConnectResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.ConnectResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(MessageType) },
+    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 12, name: "sequence", kind: "scalar", T: 4 /* ScalarType.UINT64 */ },
+    { no: 4, name: "room_created", kind: "message", T: RoomCreated, oneof: "event" },
+    { no: 5, name: "participant_joined", kind: "message", T: ParticipantJoined, oneof: "event" },
+    { no: 6, name: "participant_left", kind: "message", T: ParticipantLeft, oneof: "event" },
+    { no: 7, name: "vote_cast", kind: "message", T: VoteCast, oneof: "event" },
+    { no: 8, name: "vote_reset", kind: "message", T: VoteReset, oneof: "event" },
+    { no: 9, name: "votes_revealed", kind: "message", T: VotesRevealed, oneof: "event" },
+    { no: 10, name: "round_started", kind: "message", T: RoundStarted, oneof: "event" },
+    { no: 11, name: "room_status", kind: "message", T: RoomStatus, oneof: "event" },
+    { no: 13, name: "role_changed", kind: "message", T: RoleChanged, oneof: "event" },
+    { no: 14, name: "stories_changed", kind: "message", T: StoriesChanged, oneof: "event" },
+    { no: 15, name: "timer_started", kind: "message", T: TimerStarted, oneof: "event" },
+    { no: 16, name: "timer_tick", kind: "message", T: TimerTick, oneof: "event" },
+    { no: 17, name: "timer_expired", kind: "message", T: TimerExpired, oneof: "event" },
+    { no: 18, name: "settings_changed", kind: "message", T: SettingsChanged, oneof: "event" },
+    { no: 19, name: "room_closed", kind: "message", T: RoomClosed, oneof: "event" },
+    { no: 20, name: "room_lock_changed", kind: "message", T: RoomLockChanged, oneof: "event" },
+    { no: 21, name: "server_shutting_down", kind: "message", T: ServerShuttingDown, oneof: "event" },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectResponse {
+    return new ConnectResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectResponse {
+    return new ConnectResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectResponse {
+    return new ConnectResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ConnectResponse | PlainMessage<ConnectResponse> | undefined, b: ConnectResponse | PlainMessage<ConnectResponse> | undefined): boolean {
+    return proto3.util.equals(ConnectResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.RoomCreated
+ */
+export class RoomCreated extends Message<RoomCreated> {
+  /**
+   * @generated from field: string room_id = 1;
+   */
+  roomId = "";
+
+  /**
+   * Short code to share with the participants, e.g. "7KQ4-M2XD". It can be
+   * resolved to the room ID with ResolveInviteCode.
+   *
+   * @generated from field: string invite_code = 2;
+   */
+  inviteCode = "";
+
+  constructor(data?: PartialMessage<RoomCreated>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.RoomCreated";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "invite_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomCreated {
+    return new RoomCreated().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomCreated {
+    return new RoomCreated().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomCreated {
+    return new RoomCreated().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoomCreated | PlainMessage<RoomCreated> | undefined, b: RoomCreated | PlainMessage<RoomCreated> | undefined): boolean {
+    return proto3.util.equals(RoomCreated, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.ParticipantJoined
+ */
+export class ParticipantJoined extends Message<ParticipantJoined> {
+  /**
+   * @generated from field: string participant_id = 1;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: proto.v1.Role role = 2;
+   */
+  role = Role.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ParticipantJoined>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.ParticipantJoined";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "enum", T: proto3.getEnumType(Role) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ParticipantJoined {
+    return new ParticipantJoined().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ParticipantJoined {
+    return new ParticipantJoined().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ParticipantJoined {
+    return new ParticipantJoined().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ParticipantJoined | PlainMessage<ParticipantJoined> | undefined, b: ParticipantJoined | PlainMessage<ParticipantJoined> | undefined): boolean {
+    return proto3.util.equals(ParticipantJoined, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.ParticipantLeft
+ */
+export class ParticipantLeft extends Message<ParticipantLeft> {
+  /**
+   * @generated from field: string participant_id = 1;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: proto.v1.LeaveReason reason = 2;
+   */
+  reason = LeaveReason.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ParticipantLeft>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.ParticipantLeft";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "reason", kind: "enum", T: proto3.getEnumType(LeaveReason) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ParticipantLeft {
+    return new ParticipantLeft().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ParticipantLeft {
+    return new ParticipantLeft().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ParticipantLeft {
+    return new ParticipantLeft().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ParticipantLeft | PlainMessage<ParticipantLeft> | undefined, b: ParticipantLeft | PlainMessage<ParticipantLeft> | undefined): boolean {
+    return proto3.util.equals(ParticipantLeft, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.VoteCast
+ */
+export class VoteCast extends Message<VoteCast> {
+  /**
+   * @generated from field: string participant_id = 1;
+   */
+  participantId = "";
+
+  constructor(data?: PartialMessage<VoteCast>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.VoteCast";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoteCast {
+    return new VoteCast().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VoteCast {
+    return new VoteCast().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VoteCast {
+    return new VoteCast().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VoteCast | PlainMessage<VoteCast> | undefined, b: VoteCast | PlainMessage<VoteCast> | undefined): boolean {
+    return proto3.util.equals(VoteCast, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.VoteReset
+ */
+export class VoteReset extends Message<VoteReset> {
+  /**
+   * @generated from field: string participant_id = 1;
+   */
+  participantId = "";
+
+  constructor(data?: PartialMessage<VoteReset>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.VoteReset";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoteReset {
+    return new VoteReset().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VoteReset {
+    return new VoteReset().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VoteReset {
+    return new VoteReset().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VoteReset | PlainMessage<VoteReset> | undefined, b: VoteReset | PlainMessage<VoteReset> | undefined): boolean {
+    return proto3.util.equals(VoteReset, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.VoteEntry
+ */
+export class VoteEntry extends Message<VoteEntry> {
+  /**
+   * @generated from field: string participant_id = 1;
+   */
+  participantId = "";
+
+  /**
+   * Numeric value of the card rounded to an integer, or 0 if the card has no value.
+   *
+   * @generated from field: int32 vote = 2;
+   */
+  vote = 0;
+
+  /**
+   * @generated from field: string card = 3;
+   */
+  card = "";
+
+  /**
+   * @generated from field: optional double value = 4;
+   */
+  value?: number;
+
+  constructor(data?: PartialMessage<VoteEntry>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.VoteEntry";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "vote", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "card", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "value", kind: "scalar", T: 1 /* ScalarType.DOUBLE */, opt: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoteEntry {
+    return new VoteEntry().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VoteEntry {
+    return new VoteEntry().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VoteEntry {
+    return new VoteEntry().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VoteEntry | PlainMessage<VoteEntry> | undefined, b: VoteEntry | PlainMessage<VoteEntry> | undefined): boolean {
+    return proto3.util.equals(VoteEntry, a, b);
+  }
+}
+
+/**
+ * Numeric statistics are computed over the votes for cards with a value.
+ * Modes, spread, consensus and the lowest/highest voters are computed over
+ * every estimate, i.e. all votes except the "?" and "☕" cards, using the
+ * position of the card in the deck.
+ *
+ * @generated from message proto.v1.VoteStatistics
+ */
+export class VoteStatistics extends Message<VoteStatistics> {
+  /**
+   * @generated from field: float average = 1;
+   */
+  average = 0;
+
+  /**
+   * Number of votes for cards with a value.
+   *
+   * @generated from field: int32 count = 2;
+   */
+  count = 0;
+
+  /**
+   * @generated from field: double median = 3;
+   */
+  median = 0;
+
+  /**
+   * @generated from field: repeated string modes = 4;
+   */
+  modes: string[] = [];
+
+  /**
+   * @generated from field: double min = 5;
+   */
+  min = 0;
+
+  /**
+   * @generated from field: double max = 6;
+   */
+  max = 0;
+
+  /**
+   * @generated from field: double standard_deviation = 7;
+   */
+  standardDeviation = 0;
+
+  /**
+   * Distance in deck positions between the lowest and the highest estimate.
+   *
+   * @generated from field: int32 spread = 8;
+   */
+  spread = 0;
+
+  /**
+   * True when at least two participants voted and all of them chose the same estimate.
+   *
+   * @generated from field: bool consensus = 9;
+   */
+  consensus = false;
+
+  /**
+   * @generated from field: repeated string lowest_voters = 10;
+   */
+  lowestVoters: string[] = [];
+
+  /**
+   * @generated from field: repeated string highest_voters = 11;
+   */
+  highestVoters: string[] = [];
+
+  /**
+   * Number of votes for the "?" and "☕" cards.
+   *
+   * @generated from field: int32 abstentions = 12;
+   */
+  abstentions = 0;
+
+  constructor(data?: PartialMessage<VoteStatistics>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.VoteStatistics";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "average", kind: "scalar", T: 2 /* ScalarType.FLOAT */ },
+    { no: 2, name: "count", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "median", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 4, name: "modes", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 5, name: "min", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 6, name: "max", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 7, name: "standard_deviation", kind: "scalar", T: 1 /* ScalarType.DOUBLE */ },
+    { no: 8, name: "spread", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 9, name: "consensus", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 10, name: "lowest_voters", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 11, name: "highest_voters", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+    { no: 12, name: "abstentions", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoteStatistics {
+    return new VoteStatistics().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VoteStatistics {
+    return new VoteStatistics().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VoteStatistics {
+    return new VoteStatistics().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VoteStatistics | PlainMessage<VoteStatistics> | undefined, b: VoteStatistics | PlainMessage<VoteStatistics> | undefined): boolean {
+    return proto3.util.equals(VoteStatistics, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.VotesRevealed
+ */
+export class VotesRevealed extends Message<VotesRevealed> {
+  /**
+   * @generated from field: repeated proto.v1.VoteEntry votes = 1;
+   */
+  votes: VoteEntry[] = [];
+
+  /**
+   * @generated from field: proto.v1.VoteStatistics statistics = 2;
+   */
+  statistics?: VoteStatistics;
+
+  constructor(data?: PartialMessage<VotesRevealed>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.VotesRevealed";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "votes", kind: "message", T: VoteEntry, repeated: true },
+    { no: 2, name: "statistics", kind: "message", T: VoteStatistics },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VotesRevealed {
+    return new VotesRevealed().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VotesRevealed {
+    return new VotesRevealed().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VotesRevealed {
+    return new VotesRevealed().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VotesRevealed | PlainMessage<VotesRevealed> | undefined, b: VotesRevealed | PlainMessage<VotesRevealed> | undefined): boolean {
+    return proto3.util.equals(VotesRevealed, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.RoundStarted
+ */
+export class RoundStarted extends Message<RoundStarted> {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<RoundStarted>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.RoundStarted";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoundStarted {
+    return new RoundStarted().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoundStarted {
+    return new RoundStarted().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoundStarted {
+    return new RoundStarted().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoundStarted | PlainMessage<RoundStarted> | undefined, b: RoundStarted | PlainMessage<RoundStarted> | undefined): boolean {
+    return proto3.util.equals(RoundStarted, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.ParticipantStatus
+ */
+export class ParticipantStatus extends Message<ParticipantStatus> {
+  /**
+   * @generated from field: string participant_id = 1;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: bool voted = 2;
+   */
+  voted = false;
+
+  /**
+   * @generated from field: proto.v1.Role role = 3;
+   */
+  role = Role.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<ParticipantStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.ParticipantStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "voted", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 3, name: "role", kind: "enum", T: proto3.getEnumType(Role) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ParticipantStatus {
+    return new ParticipantStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ParticipantStatus {
+    return new ParticipantStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ParticipantStatus {
+    return new ParticipantStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ParticipantStatus | PlainMessage<ParticipantStatus> | undefined, b: ParticipantStatus | PlainMessage<ParticipantStatus> | undefined): boolean {
+    return proto3.util.equals(ParticipantStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.RoleChanged
+ */
+export class RoleChanged extends Message<RoleChanged> {
+  /**
+   * @generated from field: string participant_id = 1;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: proto.v1.Role role = 2;
+   */
+  role = Role.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<RoleChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.RoleChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "role", kind: "enum", T: proto3.getEnumType(Role) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoleChanged {
+    return new RoleChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoleChanged {
+    return new RoleChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoleChanged {
+    return new RoleChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoleChanged | PlainMessage<RoleChanged> | undefined, b: RoleChanged | PlainMessage<RoleChanged> | undefined): boolean {
+    return proto3.util.equals(RoleChanged, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.Story
+ */
+export class Story extends Message<Story> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string title = 2;
+   */
+  title = "";
+
+  /**
+   * Label of the card accepted as the final estimate. Empty until accepted.
+   *
+   * @generated from field: string estimate = 3;
+   */
+  estimate = "";
+
+  constructor(data?: PartialMessage<Story>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.Story";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "estimate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Story {
+    return new Story().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Story {
+    return new Story().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Story {
+    return new Story().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Story | PlainMessage<Story> | undefined, b: Story | PlainMessage<Story> | undefined): boolean {
+    return proto3.util.equals(Story, a, b);
+  }
+}
+
+/**
+ * Sent whenever the backlog or the current story changes.
+ *
+ * @generated from message proto.v1.StoriesChanged
+ */
+export class StoriesChanged extends Message<StoriesChanged> {
+  /**
+   * @generated from field: repeated proto.v1.Story stories = 1;
+   */
+  stories: Story[] = [];
+
+  /**
+   * Empty when no story is selected.
+   *
+   * @generated from field: string current_story_id = 2;
+   */
+  currentStoryId = "";
+
+  constructor(data?: PartialMessage<StoriesChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.StoriesChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "stories", kind: "message", T: Story, repeated: true },
+    { no: 2, name: "current_story_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StoriesChanged {
+    return new StoriesChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StoriesChanged {
+    return new StoriesChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StoriesChanged {
+    return new StoriesChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StoriesChanged | PlainMessage<StoriesChanged> | undefined, b: StoriesChanged | PlainMessage<StoriesChanged> | undefined): boolean {
+    return proto3.util.equals(StoriesChanged, a, b);
+  }
+}
+
+/**
+ * Countdown of the current round. The timer is cancelled without an event
+ * when the votes are revealed or a new game starts.
+ *
+ * @generated from message proto.v1.RoundTimer
+ */
+export class RoundTimer extends Message<RoundTimer> {
+  /**
+   * @generated from field: google.protobuf.Timestamp deadline = 1;
+   */
+  deadline?: Timestamp;
+
+  /**
+   * @generated from field: int32 duration_seconds = 2;
+   */
+  durationSeconds = 0;
+
+  /**
+   * Whether the votes are revealed automatically when the timer expires.
+   *
+   * @generated from field: bool auto_reveal = 3;
+   */
+  autoReveal = false;
+
+  /**
+   * @generated from field: int32 remaining_seconds = 4;
+   */
+  remainingSeconds = 0;
+
+  constructor(data?: PartialMessage<RoundTimer>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.RoundTimer";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "deadline", kind: "message", T: Timestamp },
+    { no: 2, name: "duration_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "auto_reveal", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 4, name: "remaining_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoundTimer {
+    return new RoundTimer().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoundTimer {
+    return new RoundTimer().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoundTimer {
+    return new RoundTimer().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoundTimer | PlainMessage<RoundTimer> | undefined, b: RoundTimer | PlainMessage<RoundTimer> | undefined): boolean {
+    return proto3.util.equals(RoundTimer, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.TimerStarted
+ */
+export class TimerStarted extends Message<TimerStarted> {
+  /**
+   * @generated from field: proto.v1.RoundTimer timer = 1;
+   */
+  timer?: RoundTimer;
+
+  constructor(data?: PartialMessage<TimerStarted>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.TimerStarted";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "timer", kind: "message", T: RoundTimer },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TimerStarted {
+    return new TimerStarted().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TimerStarted {
+    return new TimerStarted().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TimerStarted {
+    return new TimerStarted().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TimerStarted | PlainMessage<TimerStarted> | undefined, b: TimerStarted | PlainMessage<TimerStarted> | undefined): boolean {
+    return proto3.util.equals(TimerStarted, a, b);
+  }
+}
+
+/**
+ * Sent every second while the timer is running. Ticks are not recorded in
+ * the event log, so they carry the sequence 0 and are not replayed on resume.
+ *
+ * @generated from message proto.v1.TimerTick
+ */
+export class TimerTick extends Message<TimerTick> {
+  /**
+   * @generated from field: int32 remaining_seconds = 1;
+   */
+  remainingSeconds = 0;
+
+  constructor(data?: PartialMessage<TimerTick>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.TimerTick";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "remaining_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TimerTick {
+    return new TimerTick().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TimerTick {
+    return new TimerTick().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TimerTick {
+    return new TimerTick().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TimerTick | PlainMessage<TimerTick> | undefined, b: TimerTick | PlainMessage<TimerTick> | undefined): boolean {
+    return proto3.util.equals(TimerTick, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.TimerExpired
+ */
+export class TimerExpired extends Message<TimerExpired> {
+  /**
+   * True when the votes were revealed because of auto_reveal. The
+   * votes_revealed event follows in that case.
+   *
+   * @generated from field: bool revealed = 1;
+   */
+  revealed = false;
+
+  constructor(data?: PartialMessage<TimerExpired>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.TimerExpired";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "revealed", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TimerExpired {
+    return new TimerExpired().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TimerExpired {
+    return new TimerExpired().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TimerExpired {
+    return new TimerExpired().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TimerExpired | PlainMessage<TimerExpired> | undefined, b: TimerExpired | PlainMessage<TimerExpired> | undefined): boolean {
+    return proto3.util.equals(TimerExpired, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.RoomStatus
+ */
+export class RoomStatus extends Message<RoomStatus> {
+  /**
+   * @generated from field: repeated proto.v1.ParticipantStatus participants = 1;
+   */
+  participants: ParticipantStatus[] = [];
+
+  /**
+   * @generated from field: proto.v1.Deck deck = 2;
+   */
+  deck?: Deck;
+
+  /**
+   * @generated from field: repeated proto.v1.Story stories = 3;
+   */
+  stories: Story[] = [];
+
+  /**
+   * @generated from field: string current_story_id = 4;
+   */
+  currentStoryId = "";
+
+  /**
+   * Unset when no timer is running.
+   *
+   * @generated from field: proto.v1.RoundTimer timer = 5;
+   */
+  timer?: RoundTimer;
+
+  /**
+   * @generated from field: proto.v1.RoomSettings settings = 6;
+   */
+  settings?: RoomSettings;
+
+  /**
+   * @generated from field: string invite_code = 7;
+   */
+  inviteCode = "";
+
+  /**
+   * @generated from field: bool locked = 8;
+   */
+  locked = false;
+
+  constructor(data?: PartialMessage<RoomStatus>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.RoomStatus";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "participants", kind: "message", T: ParticipantStatus, repeated: true },
+    { no: 2, name: "deck", kind: "message", T: Deck },
+    { no: 3, name: "stories", kind: "message", T: Story, repeated: true },
+    { no: 4, name: "current_story_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 5, name: "timer", kind: "message", T: RoundTimer },
+    { no: 6, name: "settings", kind: "message", T: RoomSettings },
+    { no: 7, name: "invite_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 8, name: "locked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomStatus {
+    return new RoomStatus().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomStatus {
+    return new RoomStatus().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomStatus {
+    return new RoomStatus().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoomStatus | PlainMessage<RoomStatus> | undefined, b: RoomStatus | PlainMessage<RoomStatus> | undefined): boolean {
+    return proto3.util.equals(RoomStatus, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.RoomLockChanged
+ */
+export class RoomLockChanged extends Message<RoomLockChanged> {
+  /**
+   * @generated from field: bool locked = 1;
+   */
+  locked = false;
+
+  /**
+   * Facilitator who changed the lock.
+   *
+   * @generated from field: string changed_by = 2;
+   */
+  changedBy = "";
+
+  constructor(data?: PartialMessage<RoomLockChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.RoomLockChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "locked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 2, name: "changed_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomLockChanged {
+    return new RoomLockChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomLockChanged {
+    return new RoomLockChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomLockChanged {
+    return new RoomLockChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoomLockChanged | PlainMessage<RoomLockChanged> | undefined, b: RoomLockChanged | PlainMessage<RoomLockChanged> | undefined): boolean {
+    return proto3.util.equals(RoomLockChanged, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.SettingsChanged
+ */
+export class SettingsChanged extends Message<SettingsChanged> {
+  /**
+   * @generated from field: proto.v1.RoomSettings settings = 1;
+   */
+  settings?: RoomSettings;
+
+  constructor(data?: PartialMessage<SettingsChanged>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.SettingsChanged";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "settings", kind: "message", T: RoomSettings },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SettingsChanged {
+    return new SettingsChanged().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SettingsChanged {
+    return new SettingsChanged().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SettingsChanged {
+    return new SettingsChanged().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SettingsChanged | PlainMessage<SettingsChanged> | undefined, b: SettingsChanged | PlainMessage<SettingsChanged> | undefined): boolean {
+    return proto3.util.equals(SettingsChanged, a, b);
+  }
+}
+
+/**
+ * The last event of every stream in the room. The server ends the streams
+ * right after sending it.
+ *
+ * @generated from message proto.v1.RoomClosed
+ */
+export class RoomClosed extends Message<RoomClosed> {
+  /**
+   * Facilitator who closed the room. Empty when the server closed it, e.g.
+   * because the room was not used for a long time.
+   *
+   * @generated from field: string closed_by = 1;
+   */
+  closedBy = "";
+
+  constructor(data?: PartialMessage<RoomClosed>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.RoomClosed";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "closed_by", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomClosed {
+    return new RoomClosed().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomClosed {
+    return new RoomClosed().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomClosed {
+    return new RoomClosed().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RoomClosed | PlainMessage<RoomClosed> | undefined, b: RoomClosed | PlainMessage<RoomClosed> | undefined): boolean {
+    return proto3.util.equals(RoomClosed, a, b);
+  }
+}
+
+/**
+ * Sent to every participant before the server stops. The stream ends right
+ * after this event. The room is kept, so the client can call Connect again
+ * with resume_after and the session token once the server is back.
+ *
+ * @generated from message proto.v1.ServerShuttingDown
+ */
+export class ServerShuttingDown extends Message<ServerShuttingDown> {
+  constructor(data?: PartialMessage<ServerShuttingDown>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.ServerShuttingDown";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ServerShuttingDown {
+    return new ServerShuttingDown().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ServerShuttingDown {
+    return new ServerShuttingDown().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ServerShuttingDown {
+    return new ServerShuttingDown().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ServerShuttingDown | PlainMessage<ServerShuttingDown> | undefined, b: ServerShuttingDown | PlainMessage<ServerShuttingDown> | undefined): boolean {
+    return proto3.util.equals(ServerShuttingDown, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.VoteRequest
+ */
+export class VoteRequest extends Message<VoteRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * -1 resets the vote. Positive numbers are accepted as the card of the same
+   * label for clients which do not send card.
+   *
+   * @generated from field: int32 vote = 2;
+   */
+  vote = 0;
+
+  /**
+   * @generated from field: string room_id = 3;
+   */
+  roomId = "";
+
+  /**
+   * Label of the card in the room's deck.
+   *
+   * @generated from field: string card = 4;
+   */
+  card = "";
+
+  constructor(data?: PartialMessage<VoteRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.VoteRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "vote", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 3, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "card", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoteRequest {
+    return new VoteRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VoteRequest {
+    return new VoteRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VoteRequest {
+    return new VoteRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VoteRequest | PlainMessage<VoteRequest> | undefined, b: VoteRequest | PlainMessage<VoteRequest> | undefined): boolean {
+    return proto3.util.equals(VoteRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.VoteResponse
+ */
+export class VoteResponse extends Message<VoteResponse> {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<VoteResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.VoteResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoteResponse {
+    return new VoteResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VoteResponse {
+    return new VoteResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VoteResponse {
+    return new VoteResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: VoteResponse | PlainMessage<VoteResponse> | undefined, b: VoteResponse | PlainMessage<VoteResponse> | undefined): boolean {
+    return proto3.util.equals(VoteResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.ShowVotesRequest
+ */
+export class ShowVotesRequest extends Message<ShowVotesRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  constructor(data?: PartialMessage<ShowVotesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.ShowVotesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ShowVotesRequest {
+    return new ShowVotesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ShowVotesRequest {
+    return new ShowVotesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ShowVotesRequest {
+    return new ShowVotesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ShowVotesRequest | PlainMessage<ShowVotesRequest> | undefined, b: ShowVotesRequest | PlainMessage<ShowVotesRequest> | undefined): boolean {
+    return proto3.util.equals(ShowVotesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.ShowVotesResponse
+ */
+export class ShowVotesResponse extends Message<ShowVotesResponse> {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  /**
+   * @generated from field: repeated proto.v1.VoteEntry votes = 2;
+   */
+  votes: VoteEntry[] = [];
+
+  /**
+   * @generated from field: proto.v1.VoteStatistics statistics = 3;
+   */
+  statistics?: VoteStatistics;
+
+  constructor(data?: PartialMessage<ShowVotesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.ShowVotesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "votes", kind: "message", T: VoteEntry, repeated: true },
+    { no: 3, name: "statistics", kind: "message", T: VoteStatistics },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ShowVotesResponse {
+    return new ShowVotesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ShowVotesResponse {
+    return new ShowVotesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ShowVotesResponse {
+    return new ShowVotesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ShowVotesResponse | PlainMessage<ShowVotesResponse> | undefined, b: ShowVotesResponse | PlainMessage<ShowVotesResponse> | undefined): boolean {
+    return proto3.util.equals(ShowVotesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.NewGameRequest
+ */
+export class NewGameRequest extends Message<NewGameRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  constructor(data?: PartialMessage<NewGameRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.NewGameRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NewGameRequest {
+    return new NewGameRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NewGameRequest {
+    return new NewGameRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NewGameRequest {
+    return new NewGameRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NewGameRequest | PlainMessage<NewGameRequest> | undefined, b: NewGameRequest | PlainMessage<NewGameRequest> | undefined): boolean {
+    return proto3.util.equals(NewGameRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.NewGameResponse
+ */
+export class NewGameResponse extends Message<NewGameResponse> {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<NewGameResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.NewGameResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NewGameResponse {
+    return new NewGameResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NewGameResponse {
+    return new NewGameResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NewGameResponse {
+    return new NewGameResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: NewGameResponse | PlainMessage<NewGameResponse> | undefined, b: NewGameResponse | PlainMessage<NewGameResponse> | undefined): boolean {
+    return proto3.util.equals(NewGameResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.TransferFacilitatorRequest
+ */
+export class TransferFacilitatorRequest extends Message<TransferFacilitatorRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string participant_id = 3;
+   */
+  participantId = "";
+
+  constructor(data?: PartialMessage<TransferFacilitatorRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.TransferFacilitatorRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransferFacilitatorRequest {
+    return new TransferFacilitatorRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransferFacilitatorRequest {
+    return new TransferFacilitatorRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransferFacilitatorRequest {
+    return new TransferFacilitatorRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransferFacilitatorRequest | PlainMessage<TransferFacilitatorRequest> | undefined, b: TransferFacilitatorRequest | PlainMessage<TransferFacilitatorRequest> | undefined): boolean {
+    return proto3.util.equals(TransferFacilitatorRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.TransferFacilitatorResponse
+ */
+export class TransferFacilitatorResponse extends Message<TransferFacilitatorResponse> {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<TransferFacilitatorResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.TransferFacilitatorResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): TransferFacilitatorResponse {
+    return new TransferFacilitatorResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): TransferFacilitatorResponse {
+    return new TransferFacilitatorResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): TransferFacilitatorResponse {
+    return new TransferFacilitatorResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: TransferFacilitatorResponse | PlainMessage<TransferFacilitatorResponse> | undefined, b: TransferFacilitatorResponse | PlainMessage<TransferFacilitatorResponse> | undefined): boolean {
+    return proto3.util.equals(TransferFacilitatorResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.SetRoleRequest
+ */
+export class SetRoleRequest extends Message<SetRoleRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string participant_id = 3;
+   */
+  participantId = "";
+
+  /**
+   * @generated from field: proto.v1.Role role = 4;
+   */
+  role = Role.UNSPECIFIED;
+
+  constructor(data?: PartialMessage<SetRoleRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.SetRoleRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "role", kind: "enum", T: proto3.getEnumType(Role) },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetRoleRequest {
+    return new SetRoleRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetRoleRequest {
+    return new SetRoleRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetRoleRequest {
+    return new SetRoleRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetRoleRequest | PlainMessage<SetRoleRequest> | undefined, b: SetRoleRequest | PlainMessage<SetRoleRequest> | undefined): boolean {
+    return proto3.util.equals(SetRoleRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.SetRoleResponse
+ */
+export class SetRoleResponse extends Message<SetRoleResponse> {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<SetRoleResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.SetRoleResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetRoleResponse {
+    return new SetRoleResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetRoleResponse {
+    return new SetRoleResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetRoleResponse {
+    return new SetRoleResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SetRoleResponse | PlainMessage<SetRoleResponse> | undefined, b: SetRoleResponse | PlainMessage<SetRoleResponse> | undefined): boolean {
+    return proto3.util.equals(SetRoleResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.AddStoryRequest
+ */
+export class AddStoryRequest extends Message<AddStoryRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string title = 3;
+   */
+  title = "";
+
+  constructor(data?: PartialMessage<AddStoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.AddStoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddStoryRequest {
+    return new AddStoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddStoryRequest {
+    return new AddStoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddStoryRequest {
+    return new AddStoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddStoryRequest | PlainMessage<AddStoryRequest> | undefined, b: AddStoryRequest | PlainMessage<AddStoryRequest> | undefined): boolean {
+    return proto3.util.equals(AddStoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.AddStoryResponse
+ */
+export class AddStoryResponse extends Message<AddStoryResponse> {
+  /**
+   * @generated from field: proto.v1.Story story = 1;
+   */
+  story?: Story;
+
+  constructor(data?: PartialMessage<AddStoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.AddStoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "story", kind: "message", T: Story },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AddStoryResponse {
+    return new AddStoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AddStoryResponse {
+    return new AddStoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AddStoryResponse {
+    return new AddStoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AddStoryResponse | PlainMessage<AddStoryResponse> | undefined, b: AddStoryResponse | PlainMessage<AddStoryResponse> | undefined): boolean {
+    return proto3.util.equals(AddStoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.ReorderStoriesRequest
+ */
+export class ReorderStoriesRequest extends Message<ReorderStoriesRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: repeated string story_ids = 3;
+   */
+  storyIds: string[] = [];
+
+  constructor(data?: PartialMessage<ReorderStoriesRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.ReorderStoriesRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "story_ids", kind: "scalar", T: 9 /* ScalarType.STRING */, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReorderStoriesRequest {
+    return new ReorderStoriesRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReorderStoriesRequest {
+    return new ReorderStoriesRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReorderStoriesRequest {
+    return new ReorderStoriesRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReorderStoriesRequest | PlainMessage<ReorderStoriesRequest> | undefined, b: ReorderStoriesRequest | PlainMessage<ReorderStoriesRequest> | undefined): boolean {
+    return proto3.util.equals(ReorderStoriesRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.ReorderStoriesResponse
+ */
+export class ReorderStoriesResponse extends Message<ReorderStoriesResponse> {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<ReorderStoriesResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.ReorderStoriesResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ReorderStoriesResponse {
+    return new ReorderStoriesResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ReorderStoriesResponse {
+    return new ReorderStoriesResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ReorderStoriesResponse {
+    return new ReorderStoriesResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: ReorderStoriesResponse | PlainMessage<ReorderStoriesResponse> | undefined, b: ReorderStoriesResponse | PlainMessage<ReorderStoriesResponse> | undefined): boolean {
+    return proto3.util.equals(ReorderStoriesResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.RemoveStoryRequest
+ */
+export class RemoveStoryRequest extends Message<RemoveStoryRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string story_id = 3;
+   */
+  storyId = "";
+
+  constructor(data?: PartialMessage<RemoveStoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.RemoveStoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "story_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveStoryRequest {
+    return new RemoveStoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveStoryRequest {
+    return new RemoveStoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveStoryRequest {
+    return new RemoveStoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveStoryRequest | PlainMessage<RemoveStoryRequest> | undefined, b: RemoveStoryRequest | PlainMessage<RemoveStoryRequest> | undefined): boolean {
+    return proto3.util.equals(RemoveStoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.RemoveStoryResponse
+ */
+export class RemoveStoryResponse extends Message<RemoveStoryResponse> {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<RemoveStoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.RemoveStoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RemoveStoryResponse {
+    return new RemoveStoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RemoveStoryResponse {
+    return new RemoveStoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RemoveStoryResponse {
+    return new RemoveStoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: RemoveStoryResponse | PlainMessage<RemoveStoryResponse> | undefined, b: RemoveStoryResponse | PlainMessage<RemoveStoryResponse> | undefined): boolean {
+    return proto3.util.equals(RemoveStoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.SelectStoryRequest
+ */
+export class SelectStoryRequest extends Message<SelectStoryRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string story_id = 3;
+   */
+  storyId = "";
+
+  constructor(data?: PartialMessage<SelectStoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.SelectStoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "story_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SelectStoryRequest {
+    return new SelectStoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SelectStoryRequest {
+    return new SelectStoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SelectStoryRequest {
+    return new SelectStoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SelectStoryRequest | PlainMessage<SelectStoryRequest> | undefined, b: SelectStoryRequest | PlainMessage<SelectStoryRequest> | undefined): boolean {
+    return proto3.util.equals(SelectStoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.SelectStoryResponse
+ */
+export class SelectStoryResponse extends Message<SelectStoryResponse> {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<SelectStoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.SelectStoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SelectStoryResponse {
+    return new SelectStoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SelectStoryResponse {
+    return new SelectStoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SelectStoryResponse {
+    return new SelectStoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: SelectStoryResponse | PlainMessage<SelectStoryResponse> | undefined, b: SelectStoryResponse | PlainMessage<SelectStoryResponse> | undefined): boolean {
+    return proto3.util.equals(SelectStoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.AcceptEstimateRequest
+ */
+export class AcceptEstimateRequest extends Message<AcceptEstimateRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  /**
+   * Defaults to the current story.
+   *
+   * @generated from field: string story_id = 3;
+   */
+  storyId = "";
+
+  /**
+   * Label of a card in the room's deck.
+   *
+   * @generated from field: string estimate = 4;
+   */
+  estimate = "";
+
+  constructor(data?: PartialMessage<AcceptEstimateRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.AcceptEstimateRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "story_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "estimate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AcceptEstimateRequest {
+    return new AcceptEstimateRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AcceptEstimateRequest {
+    return new AcceptEstimateRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AcceptEstimateRequest {
+    return new AcceptEstimateRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AcceptEstimateRequest | PlainMessage<AcceptEstimateRequest> | undefined, b: AcceptEstimateRequest | PlainMessage<AcceptEstimateRequest> | undefined): boolean {
+    return proto3.util.equals(AcceptEstimateRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.AcceptEstimateResponse
+ */
+export class AcceptEstimateResponse extends Message<AcceptEstimateResponse> {
+  /**
+   * @generated from field: proto.v1.Story story = 1;
+   */
+  story?: Story;
+
+  constructor(data?: PartialMessage<AcceptEstimateResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.AcceptEstimateResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "story", kind: "message", T: Story },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): AcceptEstimateResponse {
+    return new AcceptEstimateResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): AcceptEstimateResponse {
+    return new AcceptEstimateResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): AcceptEstimateResponse {
+    return new AcceptEstimateResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: AcceptEstimateResponse | PlainMessage<AcceptEstimateResponse> | undefined, b: AcceptEstimateResponse | PlainMessage<AcceptEstimateResponse> | undefined): boolean {
+    return proto3.util.equals(AcceptEstimateResponse, a, b);
+  }
+}
+
+/**
+ * A round whose votes were revealed.
+ *
+ * @generated from message proto.v1.Round
+ */
+export class Round extends Message<Round> {
+  /**
+   * Story being estimated when the votes were revealed. Empty if none.
+   *
+   * @generated from field: string story_id = 1;
+   */
+  storyId = "";
+
+  /**
+   * @generated from field: string story_title = 2;
+   */
+  storyTitle = "";
+
+  /**
+   * @generated from field: repeated proto.v1.VoteEntry votes = 3;
+   */
+  votes: VoteEntry[] = [];
+
+  /**
+   * @generated from field: proto.v1.VoteStatistics statistics = 4;
+   */
+  statistics?: VoteStatistics;
+
+  /**
+   * Final estimate accepted for the story during this round.
+   *
+   * @generated from field: string estimate = 5;
+   */
+  estimate = "";
+
+  /**
+   * @generated from field: google.protobuf.Timestamp started_at = 6;
+   */
+  startedAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp revealed_at = 7;
+   */
+  revealedAt?: Timestamp;
+
+  /**
+   * Unset while the round is in progress.
+   *
+   * @generated from field: google.protobuf.Timestamp ended_at = 8;
+   */
+  endedAt?: Timestamp;
+
+  constructor(data?: PartialMessage<Round>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.Round";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "story_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "story_title", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "votes", kind: "message", T: VoteEntry, repeated: true },
+    { no: 4, name: "statistics", kind: "message", T: VoteStatistics },
+    { no: 5, name: "estimate", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 6, name: "started_at", kind: "message", T: Timestamp },
+    { no: 7, name: "revealed_at", kind: "message", T: Timestamp },
+    { no: 8, name: "ended_at", kind: "message", T: Timestamp },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): Round {
+    return new Round().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): Round {
+    return new Round().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): Round {
+    return new Round().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: Round | PlainMessage<Round> | undefined, b: Round | PlainMessage<Round> | undefined): boolean {
+    return proto3.util.equals(Round, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.GetRoomHistoryRequest
+ */
+export class GetRoomHistoryRequest extends Message<GetRoomHistoryRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  constructor(data?: PartialMessage<GetRoomHistoryRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.GetRoomHistoryRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRoomHistoryRequest {
+    return new GetRoomHistoryRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRoomHistoryRequest {
+    return new GetRoomHistoryRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRoomHistoryRequest {
+    return new GetRoomHistoryRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRoomHistoryRequest | PlainMessage<GetRoomHistoryRequest> | undefined, b: GetRoomHistoryRequest | PlainMessage<GetRoomHistoryRequest> | undefined): boolean {
+    return proto3.util.equals(GetRoomHistoryRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.GetRoomHistoryResponse
+ */
+export class GetRoomHistoryResponse extends Message<GetRoomHistoryResponse> {
+  /**
+   * @generated from field: repeated proto.v1.Round rounds = 1;
+   */
+  rounds: Round[] = [];
+
+  /**
+   * @generated from field: repeated proto.v1.Story stories = 2;
+   */
+  stories: Story[] = [];
+
+  constructor(data?: PartialMessage<GetRoomHistoryResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.GetRoomHistoryResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "rounds", kind: "message", T: Round, repeated: true },
+    { no: 2, name: "stories", kind: "message", T: Story, repeated: true },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRoomHistoryResponse {
+    return new GetRoomHistoryResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRoomHistoryResponse {
+    return new GetRoomHistoryResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRoomHistoryResponse {
+    return new GetRoomHistoryResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: GetRoomHistoryResponse | PlainMessage<GetRoomHistoryResponse> | undefined, b: GetRoomHistoryResponse | PlainMessage<GetRoomHistoryResponse> | undefined): boolean {
+    return proto3.util.equals(GetRoomHistoryResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.StartTimerRequest
+ */
+export class StartTimerRequest extends Message<StartTimerRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  /**
+   * Between 1 and 3600.
+   *
+   * @generated from field: int32 duration_seconds = 3;
+   */
+  durationSeconds = 0;
+
+  /**
+   * @generated from field: bool auto_reveal = 4;
+   */
+  autoReveal = false;
+
+  constructor(data?: PartialMessage<StartTimerRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.StartTimerRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "duration_seconds", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 4, name: "auto_reveal", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartTimerRequest {
+    return new StartTimerRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartTimerRequest {
+    return new StartTimerRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartTimerRequest {
+    return new StartTimerRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StartTimerRequest | PlainMessage<StartTimerRequest> | undefined, b: StartTimerRequest | PlainMessage<StartTimerRequest> | undefined): boolean {
+    return proto3.util.equals(StartTimerRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.StartTimerResponse
+ */
+export class StartTimerResponse extends Message<StartTimerResponse> {
+  /**
+   * @generated from field: proto.v1.RoundTimer timer = 1;
+   */
+  timer?: RoundTimer;
+
+  constructor(data?: PartialMessage<StartTimerResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.StartTimerResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "timer", kind: "message", T: RoundTimer },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): StartTimerResponse {
+    return new StartTimerResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): StartTimerResponse {
+    return new StartTimerResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): StartTimerResponse {
+    return new StartTimerResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: StartTimerResponse | PlainMessage<StartTimerResponse> | undefined, b: StartTimerResponse | PlainMessage<StartTimerResponse> | undefined): boolean {
+    return proto3.util.equals(StartTimerResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.UpdateSettingsRequest
+ */
+export class UpdateSettingsRequest extends Message<UpdateSettingsRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: proto.v1.RoomSettings settings = 3;
+   */
+  settings?: RoomSettings;
+
+  /**
+   * Fields of settings to change, e.g. "auto_reveal". The other settings are
+   * kept. When empty, every setting is replaced with settings.
+   *
+   * @generated from field: google.protobuf.FieldMask update_mask = 4;
+   */
+  updateMask?: FieldMask;
+
+  constructor(data?: PartialMessage<UpdateSettingsRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.UpdateSettingsRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "settings", kind: "message", T: RoomSettings },
+    { no: 4, name: "update_mask", kind: "message", T: FieldMask },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSettingsRequest {
+    return new UpdateSettingsRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSettingsRequest {
+    return new UpdateSettingsRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSettingsRequest {
+    return new UpdateSettingsRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSettingsRequest | PlainMessage<UpdateSettingsRequest> | undefined, b: UpdateSettingsRequest | PlainMessage<UpdateSettingsRequest> | undefined): boolean {
+    return proto3.util.equals(UpdateSettingsRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.UpdateSettingsResponse
+ */
+export class UpdateSettingsResponse extends Message<UpdateSettingsResponse> {
+  /**
+   * @generated from field: proto.v1.RoomSettings settings = 1;
+   */
+  settings?: RoomSettings;
+
+  constructor(data?: PartialMessage<UpdateSettingsResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.UpdateSettingsResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "settings", kind: "message", T: RoomSettings },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): UpdateSettingsResponse {
+    return new UpdateSettingsResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): UpdateSettingsResponse {
+    return new UpdateSettingsResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): UpdateSettingsResponse {
+    return new UpdateSettingsResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: UpdateSettingsResponse | PlainMessage<UpdateSettingsResponse> | undefined, b: UpdateSettingsResponse | PlainMessage<UpdateSettingsResponse> | undefined): boolean {
+    return proto3.util.equals(UpdateSettingsResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.LeaveRoomRequest
+ */
+export class LeaveRoomRequest extends Message<LeaveRoomRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  constructor(data?: PartialMessage<LeaveRoomRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.LeaveRoomRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaveRoomRequest {
+    return new LeaveRoomRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaveRoomRequest {
+    return new LeaveRoomRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaveRoomRequest {
+    return new LeaveRoomRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LeaveRoomRequest | PlainMessage<LeaveRoomRequest> | undefined, b: LeaveRoomRequest | PlainMessage<LeaveRoomRequest> | undefined): boolean {
+    return proto3.util.equals(LeaveRoomRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.LeaveRoomResponse
+ */
+export class LeaveRoomResponse extends Message<LeaveRoomResponse> {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<LeaveRoomResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.LeaveRoomResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): LeaveRoomResponse {
+    return new LeaveRoomResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): LeaveRoomResponse {
+    return new LeaveRoomResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): LeaveRoomResponse {
+    return new LeaveRoomResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: LeaveRoomResponse | PlainMessage<LeaveRoomResponse> | undefined, b: LeaveRoomResponse | PlainMessage<LeaveRoomResponse> | undefined): boolean {
+    return proto3.util.equals(LeaveRoomResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.KickParticipantRequest
+ */
+export class KickParticipantRequest extends Message<KickParticipantRequest> {
+  /**
+   * @generated from field: string id = 1;
+   */
+  id = "";
+
+  /**
+   * @generated from field: string room_id = 2;
+   */
+  roomId = "";
+
+  /**
+   * @generated from field: string participant_id = 3;
+   */
+  participantId = "";
+
+  constructor(data?: PartialMessage<KickParticipantRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.KickParticipantRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "participant_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KickParticipantRequest {
+    return new KickParticipantRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KickParticipantRequest {
+    return new KickParticipantRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KickParticipantRequest {
+    return new KickParticipantRequest().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KickParticipantRequest | PlainMessage<KickParticipantRequest> | undefined, b: KickParticipantRequest | PlainMessage<KickParticipantRequest> | undefined): boolean {
+    return proto3.util.equals(KickParticipantRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.KickParticipantResponse
+ */
+export class KickParticipantResponse extends Message<KickParticipantResponse> {
+  /**
+   * @generated from field: string message = 1;
+   */
+  message = "";
+
+  constructor(data?: PartialMessage<KickParticipantResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.KickParticipantResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): KickParticipantResponse {
+    return new KickParticipantResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): KickParticipantResponse {
+    return new KickParticipantResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): KickParticipantResponse {
+    return new KickParticipantResponse().fromJsonString(jsonString, options);
+  }
+
+  static equals(a: KickParticipantResponse | PlainMessage<KickParticipantResponse> | undefined, b: KickParticipantResponse | PlainMessage<KickParticipantResponse> | undefined): boolean {
+    return proto3.util.equals(KickParticipantResponse, a, b);
+  }
 }
-// Retrieve enum metadata with: proto3.getEnumType(MessageType)
-proto3.util.setEnumType(MessageType, "proto.v1.MessageType", [
-  { no: 0, name: "MESSAGE_TYPE_UNSPECIFIED" },
-  { no: 1, name: "MESSAGE_TYPE_JOIN" },
-  { no: 2, name: "MESSAGE_TYPE_VOTE" },
-  { no: 3, name: "MESSAGE_TYPE_SHOW_VOTES" },
-  { no: 4, name: "MESSAGE_TYPE_LEAVE" },
-  { no: 5, name: "MESSAGE_TYPE_NEW_GAME" },
-  { no: 6, name: "MESSAGE_TYPE_CREATE_ROOM" },
-  { no: 7, name: "MESSAGE_TYPE_STATUS" },
-  { no: 8, name: "MESSAGE_TYPE_RESET_VOTE" },
-]);
 
 /**
- * @generated from message proto.v1.CreateRoomRequest
+ * @generated from message proto.v1.CloseRoomRequest
  */
-export class CreateRoomRequest extends Message<CreateRoomRequest> {
+export class CloseRoomRequest extends Message<CloseRoomRequest> {
   /**
    * @generated from field: string id = 1;
    */
@@ -82,297 +3223,437 @@ export class CreateRoomRequest extends Message<CreateRoomRequest> {
    */
   roomId = "";
 
-  constructor(data?: PartialMessage<CreateRoomRequest>) {
+  constructor(data?: PartialMessage<CloseRoomRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "proto.v1.CreateRoomRequest";
+  static readonly typeName = "proto.v1.CloseRoomRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CreateRoomRequest {
-    return new CreateRoomRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CloseRoomRequest {
+    return new CloseRoomRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CreateRoomRequest {
-    return new CreateRoomRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CloseRoomRequest {
+    return new CloseRoomRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CreateRoomRequest {
-    return new CreateRoomRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CloseRoomRequest {
+    return new CloseRoomRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: CreateRoomRequest | PlainMessage<CreateRoomRequest> | undefined, b: CreateRoomRequest | PlainMessage<CreateRoomRequest> | undefined): boolean {
-    return proto3.util.equals(CreateRoomRequest, a, b);
+  static equals(a: CloseRoomRequest | PlainMessage<CloseRoomRequest> | undefined, b: CloseRoomRequest | PlainMessage<CloseRoomRequest> | undefined): boolean {
+    return proto3.util.equals(CloseRoomRequest, a, b);
   }
 }
 
 /**
- * @generated from message proto.v1.ConnectRequest
+ * @generated from message proto.v1.CloseRoomResponse
  */
-export class ConnectRequest extends Message<ConnectRequest> {
+export class CloseRoomResponse extends Message<CloseRoomResponse> {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: string message = 1;
    */
-  id = "";
+  message = "";
+
+  constructor(data?: PartialMessage<CloseRoomResponse>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.CloseRoomResponse";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): CloseRoomResponse {
+    return new CloseRoomResponse().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): CloseRoomResponse {
+    return new CloseRoomResponse().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): CloseRoomResponse {
+    return new CloseRoomResponse().fromJsonString(jsonString, options);
+  }
 
+  static equals(a: CloseRoomResponse | PlainMessage<CloseRoomResponse> | undefined, b: CloseRoomResponse | PlainMessage<CloseRoomResponse> | undefined): boolean {
+    return proto3.util.equals(CloseRoomResponse, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.RoomInfo
+ */
+export class RoomInfo extends Message<RoomInfo> {
   /**
-   * @generated from field: string room_id = 2;
+   * @generated from field: string room_id = 1;
    */
   roomId = "";
 
-  constructor(data?: PartialMessage<ConnectRequest>) {
+  /**
+   * Connected participants and whether they have voted. Votes themselves are
+   * not disclosed.
+   *
+   * @generated from field: repeated proto.v1.ParticipantStatus participants = 2;
+   */
+  participants: ParticipantStatus[] = [];
+
+  /**
+   * @generated from field: proto.v1.Deck deck = 3;
+   */
+  deck?: Deck;
+
+  /**
+   * @generated from field: proto.v1.RoomSettings settings = 4;
+   */
+  settings?: RoomSettings;
+
+  /**
+   * Unset when no story is selected.
+   *
+   * @generated from field: proto.v1.Story current_story = 5;
+   */
+  currentStory?: Story;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp created_at = 6;
+   */
+  createdAt?: Timestamp;
+
+  /**
+   * @generated from field: google.protobuf.Timestamp last_used_at = 7;
+   */
+  lastUsedAt?: Timestamp;
+
+  /**
+   * @generated from field: bool locked = 8;
+   */
+  locked = false;
+
+  /**
+   * Whether Connect requires a passphrase.
+   *
+   * @generated from field: bool has_passphrase = 9;
+   */
+  hasPassphrase = false;
+
+  constructor(data?: PartialMessage<RoomInfo>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "proto.v1.ConnectRequest";
+  static readonly typeName = "proto.v1.RoomInfo";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 2, name: "participants", kind: "message", T: ParticipantStatus, repeated: true },
+    { no: 3, name: "deck", kind: "message", T: Deck },
+    { no: 4, name: "settings", kind: "message", T: RoomSettings },
+    { no: 5, name: "current_story", kind: "message", T: Story },
+    { no: 6, name: "created_at", kind: "message", T: Timestamp },
+    { no: 7, name: "last_used_at", kind: "message", T: Timestamp },
+    { no: 8, name: "locked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
+    { no: 9, name: "has_passphrase", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectRequest {
-    return new ConnectRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): RoomInfo {
+    return new RoomInfo().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectRequest {
-    return new ConnectRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): RoomInfo {
+    return new RoomInfo().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectRequest {
-    return new ConnectRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): RoomInfo {
+    return new RoomInfo().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ConnectRequest | PlainMessage<ConnectRequest> | undefined, b: ConnectRequest | PlainMessage<ConnectRequest> | undefined): boolean {
-    return proto3.util.equals(ConnectRequest, a, b);
+  static equals(a: RoomInfo | PlainMessage<RoomInfo> | undefined, b: RoomInfo | PlainMessage<RoomInfo> | undefined): boolean {
+    return proto3.util.equals(RoomInfo, a, b);
   }
 }
 
 /**
- * @generated from message proto.v1.ConnectResponse
+ * @generated from message proto.v1.GetRoomRequest
  */
-export class ConnectResponse extends Message<ConnectResponse> {
+export class GetRoomRequest extends Message<GetRoomRequest> {
   /**
-   * @generated from field: string id = 1;
+   * @generated from field: string room_id = 1;
    */
-  id = "";
+  roomId = "";
 
   /**
-   * @generated from field: proto.v1.MessageType type = 2;
+   * Participants and the current story of a room with a passphrase are
+   * disclosed only to a participant who sends id with the session header.
+   * Otherwise they are left out.
+   *
+   * @generated from field: string id = 3;
    */
-  type = MessageType.UNSPECIFIED;
+  id = "";
+
+  constructor(data?: PartialMessage<GetRoomRequest>) {
+    super();
+    proto3.util.initPartial(data, this);
+  }
+
+  static readonly runtime: typeof proto3 = proto3;
+  static readonly typeName = "proto.v1.GetRoomRequest";
+  static readonly fields: FieldList = proto3.util.newFieldList(() => [
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+  ]);
+
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRoomRequest {
+    return new GetRoomRequest().fromBinary(bytes, options);
+  }
+
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRoomRequest {
+    return new GetRoomRequest().fromJson(jsonValue, options);
+  }
+
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRoomRequest {
+    return new GetRoomRequest().fromJsonString(jsonString, options);
+  }
 
+  static equals(a: GetRoomRequest | PlainMessage<GetRoomRequest> | undefined, b: GetRoomRequest | PlainMessage<GetRoomRequest> | undefined): boolean {
+    return proto3.util.equals(GetRoomRequest, a, b);
+  }
+}
+
+/**
+ * @generated from message proto.v1.GetRoomResponse
+ */
+export class GetRoomResponse extends Message<GetRoomResponse> {
   /**
-   * @generated from field: string message = 3;
+   * @generated from field: proto.v1.RoomInfo room = 1;
    */
-  message = "";
+  room?: RoomInfo;
 
-  constructor(data?: PartialMessage<ConnectResponse>) {
+  constructor(data?: PartialMessage<GetRoomResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "proto.v1.ConnectResponse";
+  static readonly typeName = "proto.v1.GetRoomResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "type", kind: "enum", T: proto3.getEnumType(MessageType) },
-    { no: 3, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "room", kind: "message", T: RoomInfo },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ConnectResponse {
-    return new ConnectResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): GetRoomResponse {
+    return new GetRoomResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ConnectResponse {
-    return new ConnectResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): GetRoomResponse {
+    return new GetRoomResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ConnectResponse {
-    return new ConnectResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): GetRoomResponse {
+    return new GetRoomResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ConnectResponse | PlainMessage<ConnectResponse> | undefined, b: ConnectResponse | PlainMessage<ConnectResponse> | undefined): boolean {
-    return proto3.util.equals(ConnectResponse, a, b);
+  static equals(a: GetRoomResponse | PlainMessage<GetRoomResponse> | undefined, b: GetRoomResponse | PlainMessage<GetRoomResponse> | undefined): boolean {
+    return proto3.util.equals(GetRoomResponse, a, b);
   }
 }
 
 /**
- * @generated from message proto.v1.VoteRequest
+ * @generated from message proto.v1.ListRoomsRequest
  */
-export class VoteRequest extends Message<VoteRequest> {
+export class ListRoomsRequest extends Message<ListRoomsRequest> {
   /**
-   * @generated from field: string id = 1;
+   * Defaults to 20. At most 100.
+   *
+   * @generated from field: int32 page_size = 1;
    */
-  id = "";
+  pageSize = 0;
 
   /**
-   * @generated from field: int32 vote = 2;
+   * next_page_token of the previous response.
+   *
+   * @generated from field: string page_token = 2;
    */
-  vote = 0;
+  pageToken = "";
 
   /**
-   * @generated from field: string room_id = 3;
+   * Only rooms whose ID contains this string, ignoring case.
+   *
+   * @generated from field: string query = 3;
    */
-  roomId = "";
+  query = "";
 
-  constructor(data?: PartialMessage<VoteRequest>) {
+  /**
+   * Excludes the rooms nobody is connected to. Participants and the current
+   * story of rooms with a passphrase are left out as in GetRoom.
+   *
+   * @generated from field: bool active_only = 4;
+   */
+  activeOnly = false;
+
+  constructor(data?: PartialMessage<ListRoomsRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "proto.v1.VoteRequest";
+  static readonly typeName = "proto.v1.ListRoomsRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "vote", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
-    { no: 3, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "page_size", kind: "scalar", T: 5 /* ScalarType.INT32 */ },
+    { no: 2, name: "page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "query", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 4, name: "active_only", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoteRequest {
-    return new VoteRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRoomsRequest {
+    return new ListRoomsRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VoteRequest {
-    return new VoteRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRoomsRequest {
+    return new ListRoomsRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VoteRequest {
-    return new VoteRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRoomsRequest {
+    return new ListRoomsRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: VoteRequest | PlainMessage<VoteRequest> | undefined, b: VoteRequest | PlainMessage<VoteRequest> | undefined): boolean {
-    return proto3.util.equals(VoteRequest, a, b);
+  static equals(a: ListRoomsRequest | PlainMessage<ListRoomsRequest> | undefined, b: ListRoomsRequest | PlainMessage<ListRoomsRequest> | undefined): boolean {
+    return proto3.util.equals(ListRoomsRequest, a, b);
   }
 }
 
 /**
- * @generated from message proto.v1.VoteResponse
+ * @generated from message proto.v1.ListRoomsResponse
  */
-export class VoteResponse extends Message<VoteResponse> {
+export class ListRoomsResponse extends Message<ListRoomsResponse> {
   /**
-   * @generated from field: string message = 1;
+   * @generated from field: repeated proto.v1.RoomInfo rooms = 1;
    */
-  message = "";
+  rooms: RoomInfo[] = [];
 
-  constructor(data?: PartialMessage<VoteResponse>) {
+  /**
+   * Empty when there are no more rooms.
+   *
+   * @generated from field: string next_page_token = 2;
+   */
+  nextPageToken = "";
+
+  constructor(data?: PartialMessage<ListRoomsResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "proto.v1.VoteResponse";
+  static readonly typeName = "proto.v1.ListRoomsResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "rooms", kind: "message", T: RoomInfo, repeated: true },
+    { no: 2, name: "next_page_token", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): VoteResponse {
-    return new VoteResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ListRoomsResponse {
+    return new ListRoomsResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): VoteResponse {
-    return new VoteResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ListRoomsResponse {
+    return new ListRoomsResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): VoteResponse {
-    return new VoteResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ListRoomsResponse {
+    return new ListRoomsResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: VoteResponse | PlainMessage<VoteResponse> | undefined, b: VoteResponse | PlainMessage<VoteResponse> | undefined): boolean {
-    return proto3.util.equals(VoteResponse, a, b);
+  static equals(a: ListRoomsResponse | PlainMessage<ListRoomsResponse> | undefined, b: ListRoomsResponse | PlainMessage<ListRoomsResponse> | undefined): boolean {
+    return proto3.util.equals(ListRoomsResponse, a, b);
   }
 }
 
 /**
- * @generated from message proto.v1.ShowVotesRequest
+ * @generated from message proto.v1.ResolveInviteCodeRequest
  */
-export class ShowVotesRequest extends Message<ShowVotesRequest> {
-  /**
-   * @generated from field: string id = 1;
-   */
-  id = "";
-
+export class ResolveInviteCodeRequest extends Message<ResolveInviteCodeRequest> {
   /**
-   * @generated from field: string room_id = 2;
+   * Case, hyphens and spaces are ignored.
+   *
+   * @generated from field: string invite_code = 1;
    */
-  roomId = "";
+  inviteCode = "";
 
-  constructor(data?: PartialMessage<ShowVotesRequest>) {
+  constructor(data?: PartialMessage<ResolveInviteCodeRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "proto.v1.ShowVotesRequest";
+  static readonly typeName = "proto.v1.ResolveInviteCodeRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
-    { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "invite_code", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ShowVotesRequest {
-    return new ShowVotesRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolveInviteCodeRequest {
+    return new ResolveInviteCodeRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ShowVotesRequest {
-    return new ShowVotesRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResolveInviteCodeRequest {
+    return new ResolveInviteCodeRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ShowVotesRequest {
-    return new ShowVotesRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResolveInviteCodeRequest {
+    return new ResolveInviteCodeRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ShowVotesRequest | PlainMessage<ShowVotesRequest> | undefined, b: ShowVotesRequest | PlainMessage<ShowVotesRequest> | undefined): boolean {
-    return proto3.util.equals(ShowVotesRequest, a, b);
+  static equals(a: ResolveInviteCodeRequest | PlainMessage<ResolveInviteCodeRequest> | undefined, b: ResolveInviteCodeRequest | PlainMessage<ResolveInviteCodeRequest> | undefined): boolean {
+    return proto3.util.equals(ResolveInviteCodeRequest, a, b);
   }
 }
 
 /**
- * @generated from message proto.v1.ShowVotesResponse
+ * @generated from message proto.v1.ResolveInviteCodeResponse
  */
-export class ShowVotesResponse extends Message<ShowVotesResponse> {
+export class ResolveInviteCodeResponse extends Message<ResolveInviteCodeResponse> {
   /**
-   * @generated from field: string message = 1;
+   * @generated from field: string room_id = 1;
    */
-  message = "";
+  roomId = "";
 
-  constructor(data?: PartialMessage<ShowVotesResponse>) {
+  constructor(data?: PartialMessage<ResolveInviteCodeResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "proto.v1.ShowVotesResponse";
+  static readonly typeName = "proto.v1.ResolveInviteCodeResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ShowVotesResponse {
-    return new ShowVotesResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): ResolveInviteCodeResponse {
+    return new ResolveInviteCodeResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ShowVotesResponse {
-    return new ShowVotesResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): ResolveInviteCodeResponse {
+    return new ResolveInviteCodeResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ShowVotesResponse {
-    return new ShowVotesResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): ResolveInviteCodeResponse {
+    return new ResolveInviteCodeResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: ShowVotesResponse | PlainMessage<ShowVotesResponse> | undefined, b: ShowVotesResponse | PlainMessage<ShowVotesResponse> | undefined): boolean {
-    return proto3.util.equals(ShowVotesResponse, a, b);
+  static equals(a: ResolveInviteCodeResponse | PlainMessage<ResolveInviteCodeResponse> | undefined, b: ResolveInviteCodeResponse | PlainMessage<ResolveInviteCodeResponse> | undefined): boolean {
+    return proto3.util.equals(ResolveInviteCodeResponse, a, b);
   }
 }
 
 /**
- * @generated from message proto.v1.NewGameRequest
+ * @generated from message proto.v1.SetRoomLockRequest
  */
-export class NewGameRequest extends Message<NewGameRequest> {
+export class SetRoomLockRequest extends Message<SetRoomLockRequest> {
   /**
    * @generated from field: string id = 1;
    */
@@ -383,69 +3664,75 @@ export class NewGameRequest extends Message<NewGameRequest> {
    */
   roomId = "";
 
-  constructor(data?: PartialMessage<NewGameRequest>) {
+  /**
+   * @generated from field: bool locked = 3;
+   */
+  locked = false;
+
+  constructor(data?: PartialMessage<SetRoomLockRequest>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "proto.v1.NewGameRequest";
+  static readonly typeName = "proto.v1.SetRoomLockRequest";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
     { no: 1, name: "id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
     { no: 2, name: "room_id", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 3, name: "locked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NewGameRequest {
-    return new NewGameRequest().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetRoomLockRequest {
+    return new SetRoomLockRequest().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NewGameRequest {
-    return new NewGameRequest().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetRoomLockRequest {
+    return new SetRoomLockRequest().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NewGameRequest {
-    return new NewGameRequest().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetRoomLockRequest {
+    return new SetRoomLockRequest().fromJsonString(jsonString, options);
   }
 
-  static equals(a: NewGameRequest | PlainMessage<NewGameRequest> | undefined, b: NewGameRequest | PlainMessage<NewGameRequest> | undefined): boolean {
-    return proto3.util.equals(NewGameRequest, a, b);
+  static equals(a: SetRoomLockRequest | PlainMessage<SetRoomLockRequest> | undefined, b: SetRoomLockRequest | PlainMessage<SetRoomLockRequest> | undefined): boolean {
+    return proto3.util.equals(SetRoomLockRequest, a, b);
   }
 }
 
 /**
- * @generated from message proto.v1.NewGameResponse
+ * @generated from message proto.v1.SetRoomLockResponse
  */
-export class NewGameResponse extends Message<NewGameResponse> {
+export class SetRoomLockResponse extends Message<SetRoomLockResponse> {
   /**
-   * @generated from field: string message = 1;
+   * @generated from field: bool locked = 1;
    */
-  message = "";
+  locked = false;
 
-  constructor(data?: PartialMessage<NewGameResponse>) {
+  constructor(data?: PartialMessage<SetRoomLockResponse>) {
     super();
     proto3.util.initPartial(data, this);
   }
 
   static readonly runtime: typeof proto3 = proto3;
-  static readonly typeName = "proto.v1.NewGameResponse";
+  static readonly typeName = "proto.v1.SetRoomLockResponse";
   static readonly fields: FieldList = proto3.util.newFieldList(() => [
-    { no: 1, name: "message", kind: "scalar", T: 9 /* ScalarType.STRING */ },
+    { no: 1, name: "locked", kind: "scalar", T: 8 /* ScalarType.BOOL */ },
   ]);
 
-  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): NewGameResponse {
-    return new NewGameResponse().fromBinary(bytes, options);
+  static fromBinary(bytes: Uint8Array, options?: Partial<BinaryReadOptions>): SetRoomLockResponse {
+    return new SetRoomLockResponse().fromBinary(bytes, options);
   }
 
-  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): NewGameResponse {
-    return new NewGameResponse().fromJson(jsonValue, options);
+  static fromJson(jsonValue: JsonValue, options?: Partial<JsonReadOptions>): SetRoomLockResponse {
+    return new SetRoomLockResponse().fromJson(jsonValue, options);
   }
 
-  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): NewGameResponse {
-    return new NewGameResponse().fromJsonString(jsonString, options);
+  static fromJsonString(jsonString: string, options?: Partial<JsonReadOptions>): SetRoomLockResponse {
+    return new SetRoomLockResponse().fromJsonString(jsonString, options);
   }
 
-  static equals(a: NewGameResponse | PlainMessage<NewGameResponse> | undefined, b: NewGameResponse | PlainMessage<NewGameResponse> | undefined): boolean {
-    return proto3.util.equals(NewGameResponse, a, b);
+  static equals(a: SetRoomLockResponse | PlainMessage<SetRoomLockResponse> | undefined, b: SetRoomLockResponse | PlainMessage<SetRoomLockResponse> | undefined): boolean {
+    return proto3.util.equals(SetRoomLockResponse, a, b);
   }
 }
 
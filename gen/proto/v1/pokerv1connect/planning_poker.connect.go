@@ -48,6 +48,57 @@ const (
 	// PlanningPokerServiceNewGameProcedure is the fully-qualified name of the PlanningPokerService's
 	// NewGame RPC.
 	PlanningPokerServiceNewGameProcedure = "/proto.v1.PlanningPokerService/NewGame"
+	// PlanningPokerServiceTransferFacilitatorProcedure is the fully-qualified name of the
+	// PlanningPokerService's TransferFacilitator RPC.
+	PlanningPokerServiceTransferFacilitatorProcedure = "/proto.v1.PlanningPokerService/TransferFacilitator"
+	// PlanningPokerServiceSetRoleProcedure is the fully-qualified name of the PlanningPokerService's
+	// SetRole RPC.
+	PlanningPokerServiceSetRoleProcedure = "/proto.v1.PlanningPokerService/SetRole"
+	// PlanningPokerServiceAddStoryProcedure is the fully-qualified name of the PlanningPokerService's
+	// AddStory RPC.
+	PlanningPokerServiceAddStoryProcedure = "/proto.v1.PlanningPokerService/AddStory"
+	// PlanningPokerServiceReorderStoriesProcedure is the fully-qualified name of the
+	// PlanningPokerService's ReorderStories RPC.
+	PlanningPokerServiceReorderStoriesProcedure = "/proto.v1.PlanningPokerService/ReorderStories"
+	// PlanningPokerServiceRemoveStoryProcedure is the fully-qualified name of the
+	// PlanningPokerService's RemoveStory RPC.
+	PlanningPokerServiceRemoveStoryProcedure = "/proto.v1.PlanningPokerService/RemoveStory"
+	// PlanningPokerServiceSelectStoryProcedure is the fully-qualified name of the
+	// PlanningPokerService's SelectStory RPC.
+	PlanningPokerServiceSelectStoryProcedure = "/proto.v1.PlanningPokerService/SelectStory"
+	// PlanningPokerServiceAcceptEstimateProcedure is the fully-qualified name of the
+	// PlanningPokerService's AcceptEstimate RPC.
+	PlanningPokerServiceAcceptEstimateProcedure = "/proto.v1.PlanningPokerService/AcceptEstimate"
+	// PlanningPokerServiceGetRoomHistoryProcedure is the fully-qualified name of the
+	// PlanningPokerService's GetRoomHistory RPC.
+	PlanningPokerServiceGetRoomHistoryProcedure = "/proto.v1.PlanningPokerService/GetRoomHistory"
+	// PlanningPokerServiceStartTimerProcedure is the fully-qualified name of the PlanningPokerService's
+	// StartTimer RPC.
+	PlanningPokerServiceStartTimerProcedure = "/proto.v1.PlanningPokerService/StartTimer"
+	// PlanningPokerServiceUpdateSettingsProcedure is the fully-qualified name of the
+	// PlanningPokerService's UpdateSettings RPC.
+	PlanningPokerServiceUpdateSettingsProcedure = "/proto.v1.PlanningPokerService/UpdateSettings"
+	// PlanningPokerServiceLeaveRoomProcedure is the fully-qualified name of the PlanningPokerService's
+	// LeaveRoom RPC.
+	PlanningPokerServiceLeaveRoomProcedure = "/proto.v1.PlanningPokerService/LeaveRoom"
+	// PlanningPokerServiceKickParticipantProcedure is the fully-qualified name of the
+	// PlanningPokerService's KickParticipant RPC.
+	PlanningPokerServiceKickParticipantProcedure = "/proto.v1.PlanningPokerService/KickParticipant"
+	// PlanningPokerServiceCloseRoomProcedure is the fully-qualified name of the PlanningPokerService's
+	// CloseRoom RPC.
+	PlanningPokerServiceCloseRoomProcedure = "/proto.v1.PlanningPokerService/CloseRoom"
+	// PlanningPokerServiceGetRoomProcedure is the fully-qualified name of the PlanningPokerService's
+	// GetRoom RPC.
+	PlanningPokerServiceGetRoomProcedure = "/proto.v1.PlanningPokerService/GetRoom"
+	// PlanningPokerServiceListRoomsProcedure is the fully-qualified name of the PlanningPokerService's
+	// ListRooms RPC.
+	PlanningPokerServiceListRoomsProcedure = "/proto.v1.PlanningPokerService/ListRooms"
+	// PlanningPokerServiceResolveInviteCodeProcedure is the fully-qualified name of the
+	// PlanningPokerService's ResolveInviteCode RPC.
+	PlanningPokerServiceResolveInviteCodeProcedure = "/proto.v1.PlanningPokerService/ResolveInviteCode"
+	// PlanningPokerServiceSetRoomLockProcedure is the fully-qualified name of the
+	// PlanningPokerService's SetRoomLock RPC.
+	PlanningPokerServiceSetRoomLockProcedure = "/proto.v1.PlanningPokerService/SetRoomLock"
 )
 
 // PlanningPokerServiceClient is a client for the proto.v1.PlanningPokerService service.
@@ -57,6 +108,46 @@ type PlanningPokerServiceClient interface {
 	Vote(context.Context, *connect.Request[v1.VoteRequest]) (*connect.Response[v1.VoteResponse], error)
 	ShowVotes(context.Context, *connect.Request[v1.ShowVotesRequest]) (*connect.Response[v1.ShowVotesResponse], error)
 	NewGame(context.Context, *connect.Request[v1.NewGameRequest]) (*connect.Response[v1.NewGameResponse], error)
+	// Hands the facilitator role of the caller over to another participant.
+	TransferFacilitator(context.Context, *connect.Request[v1.TransferFacilitatorRequest]) (*connect.Response[v1.TransferFacilitatorResponse], error)
+	// Changes the role of a participant. Used to share the facilitator role.
+	SetRole(context.Context, *connect.Request[v1.SetRoleRequest]) (*connect.Response[v1.SetRoleResponse], error)
+	// Story backlog of the room. Only the facilitator can change it.
+	AddStory(context.Context, *connect.Request[v1.AddStoryRequest]) (*connect.Response[v1.AddStoryResponse], error)
+	// Reorders the backlog. story_ids must contain every story of the room.
+	ReorderStories(context.Context, *connect.Request[v1.ReorderStoriesRequest]) (*connect.Response[v1.ReorderStoriesResponse], error)
+	RemoveStory(context.Context, *connect.Request[v1.RemoveStoryRequest]) (*connect.Response[v1.RemoveStoryResponse], error)
+	// Makes the story the one being estimated in the current round.
+	SelectStory(context.Context, *connect.Request[v1.SelectStoryRequest]) (*connect.Response[v1.SelectStoryResponse], error)
+	// Records the final estimate agreed on for a story.
+	AcceptEstimate(context.Context, *connect.Request[v1.AcceptEstimateRequest]) (*connect.Response[v1.AcceptEstimateResponse], error)
+	// Returns the revealed rounds of the room in the order they were played.
+	GetRoomHistory(context.Context, *connect.Request[v1.GetRoomHistoryRequest]) (*connect.Response[v1.GetRoomHistoryResponse], error)
+	// Starts a countdown for the current round. Only the facilitator can start it.
+	// Starting a timer while another one is running replaces it.
+	StartTimer(context.Context, *connect.Request[v1.StartTimerRequest]) (*connect.Response[v1.StartTimerResponse], error)
+	// Changes the settings of the room listed in update_mask. Only the
+	// facilitator can change them.
+	UpdateSettings(context.Context, *connect.Request[v1.UpdateSettingsRequest]) (*connect.Response[v1.UpdateSettingsResponse], error)
+	// Leaves the room and ends the Connect stream of the caller.
+	LeaveRoom(context.Context, *connect.Request[v1.LeaveRoomRequest]) (*connect.Response[v1.LeaveRoomResponse], error)
+	// Removes a participant from the room and clears their vote. Only the
+	// facilitator can kick participants, e.g. ones who left without closing
+	// their stream.
+	KickParticipant(context.Context, *connect.Request[v1.KickParticipantRequest]) (*connect.Response[v1.KickParticipantResponse], error)
+	// Closes the room for everyone. Only the facilitator can close it.
+	CloseRoom(context.Context, *connect.Request[v1.CloseRoomRequest]) (*connect.Response[v1.CloseRoomResponse], error)
+	// Returns the current state of a room without joining it. Participants and
+	// the current story of a room with a passphrase are left out unless the
+	// caller is a participant of the room.
+	GetRoom(context.Context, *connect.Request[v1.GetRoomRequest]) (*connect.Response[v1.GetRoomResponse], error)
+	// Lists the public rooms ordered by their IDs.
+	ListRooms(context.Context, *connect.Request[v1.ListRoomsRequest]) (*connect.Response[v1.ListRoomsResponse], error)
+	// Returns the ID of the room an invite code was issued for.
+	ResolveInviteCode(context.Context, *connect.Request[v1.ResolveInviteCodeRequest]) (*connect.Response[v1.ResolveInviteCodeResponse], error)
+	// Locks or unlocks the room. A locked room refuses new participants.
+	// Only the facilitator can change it.
+	SetRoomLock(context.Context, *connect.Request[v1.SetRoomLockRequest]) (*connect.Response[v1.SetRoomLockResponse], error)
 }
 
 // NewPlanningPokerServiceClient constructs a client for the proto.v1.PlanningPokerService service.
@@ -94,16 +185,118 @@ func NewPlanningPokerServiceClient(httpClient connect.HTTPClient, baseURL string
 			baseURL+PlanningPokerServiceNewGameProcedure,
 			opts...,
 		),
+		transferFacilitator: connect.NewClient[v1.TransferFacilitatorRequest, v1.TransferFacilitatorResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceTransferFacilitatorProcedure,
+			opts...,
+		),
+		setRole: connect.NewClient[v1.SetRoleRequest, v1.SetRoleResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceSetRoleProcedure,
+			opts...,
+		),
+		addStory: connect.NewClient[v1.AddStoryRequest, v1.AddStoryResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceAddStoryProcedure,
+			opts...,
+		),
+		reorderStories: connect.NewClient[v1.ReorderStoriesRequest, v1.ReorderStoriesResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceReorderStoriesProcedure,
+			opts...,
+		),
+		removeStory: connect.NewClient[v1.RemoveStoryRequest, v1.RemoveStoryResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceRemoveStoryProcedure,
+			opts...,
+		),
+		selectStory: connect.NewClient[v1.SelectStoryRequest, v1.SelectStoryResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceSelectStoryProcedure,
+			opts...,
+		),
+		acceptEstimate: connect.NewClient[v1.AcceptEstimateRequest, v1.AcceptEstimateResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceAcceptEstimateProcedure,
+			opts...,
+		),
+		getRoomHistory: connect.NewClient[v1.GetRoomHistoryRequest, v1.GetRoomHistoryResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceGetRoomHistoryProcedure,
+			opts...,
+		),
+		startTimer: connect.NewClient[v1.StartTimerRequest, v1.StartTimerResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceStartTimerProcedure,
+			opts...,
+		),
+		updateSettings: connect.NewClient[v1.UpdateSettingsRequest, v1.UpdateSettingsResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceUpdateSettingsProcedure,
+			opts...,
+		),
+		leaveRoom: connect.NewClient[v1.LeaveRoomRequest, v1.LeaveRoomResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceLeaveRoomProcedure,
+			opts...,
+		),
+		kickParticipant: connect.NewClient[v1.KickParticipantRequest, v1.KickParticipantResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceKickParticipantProcedure,
+			opts...,
+		),
+		closeRoom: connect.NewClient[v1.CloseRoomRequest, v1.CloseRoomResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceCloseRoomProcedure,
+			opts...,
+		),
+		getRoom: connect.NewClient[v1.GetRoomRequest, v1.GetRoomResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceGetRoomProcedure,
+			opts...,
+		),
+		listRooms: connect.NewClient[v1.ListRoomsRequest, v1.ListRoomsResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceListRoomsProcedure,
+			opts...,
+		),
+		resolveInviteCode: connect.NewClient[v1.ResolveInviteCodeRequest, v1.ResolveInviteCodeResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceResolveInviteCodeProcedure,
+			opts...,
+		),
+		setRoomLock: connect.NewClient[v1.SetRoomLockRequest, v1.SetRoomLockResponse](
+			httpClient,
+			baseURL+PlanningPokerServiceSetRoomLockProcedure,
+			opts...,
+		),
 	}
 }
 
 // planningPokerServiceClient implements PlanningPokerServiceClient.
 type planningPokerServiceClient struct {
-	createRoom *connect.Client[v1.CreateRoomRequest, v1.ConnectResponse]
-	connect    *connect.Client[v1.ConnectRequest, v1.ConnectResponse]
-	vote       *connect.Client[v1.VoteRequest, v1.VoteResponse]
-	showVotes  *connect.Client[v1.ShowVotesRequest, v1.ShowVotesResponse]
-	newGame    *connect.Client[v1.NewGameRequest, v1.NewGameResponse]
+	createRoom          *connect.Client[v1.CreateRoomRequest, v1.ConnectResponse]
+	connect             *connect.Client[v1.ConnectRequest, v1.ConnectResponse]
+	vote                *connect.Client[v1.VoteRequest, v1.VoteResponse]
+	showVotes           *connect.Client[v1.ShowVotesRequest, v1.ShowVotesResponse]
+	newGame             *connect.Client[v1.NewGameRequest, v1.NewGameResponse]
+	transferFacilitator *connect.Client[v1.TransferFacilitatorRequest, v1.TransferFacilitatorResponse]
+	setRole             *connect.Client[v1.SetRoleRequest, v1.SetRoleResponse]
+	addStory            *connect.Client[v1.AddStoryRequest, v1.AddStoryResponse]
+	reorderStories      *connect.Client[v1.ReorderStoriesRequest, v1.ReorderStoriesResponse]
+	removeStory         *connect.Client[v1.RemoveStoryRequest, v1.RemoveStoryResponse]
+	selectStory         *connect.Client[v1.SelectStoryRequest, v1.SelectStoryResponse]
+	acceptEstimate      *connect.Client[v1.AcceptEstimateRequest, v1.AcceptEstimateResponse]
+	getRoomHistory      *connect.Client[v1.GetRoomHistoryRequest, v1.GetRoomHistoryResponse]
+	startTimer          *connect.Client[v1.StartTimerRequest, v1.StartTimerResponse]
+	updateSettings      *connect.Client[v1.UpdateSettingsRequest, v1.UpdateSettingsResponse]
+	leaveRoom           *connect.Client[v1.LeaveRoomRequest, v1.LeaveRoomResponse]
+	kickParticipant     *connect.Client[v1.KickParticipantRequest, v1.KickParticipantResponse]
+	closeRoom           *connect.Client[v1.CloseRoomRequest, v1.CloseRoomResponse]
+	getRoom             *connect.Client[v1.GetRoomRequest, v1.GetRoomResponse]
+	listRooms           *connect.Client[v1.ListRoomsRequest, v1.ListRoomsResponse]
+	resolveInviteCode   *connect.Client[v1.ResolveInviteCodeRequest, v1.ResolveInviteCodeResponse]
+	setRoomLock         *connect.Client[v1.SetRoomLockRequest, v1.SetRoomLockResponse]
 }
 
 // CreateRoom calls proto.v1.PlanningPokerService.CreateRoom.
@@ -131,6 +324,91 @@ func (c *planningPokerServiceClient) NewGame(ctx context.Context, req *connect.R
 	return c.newGame.CallUnary(ctx, req)
 }
 
+// TransferFacilitator calls proto.v1.PlanningPokerService.TransferFacilitator.
+func (c *planningPokerServiceClient) TransferFacilitator(ctx context.Context, req *connect.Request[v1.TransferFacilitatorRequest]) (*connect.Response[v1.TransferFacilitatorResponse], error) {
+	return c.transferFacilitator.CallUnary(ctx, req)
+}
+
+// SetRole calls proto.v1.PlanningPokerService.SetRole.
+func (c *planningPokerServiceClient) SetRole(ctx context.Context, req *connect.Request[v1.SetRoleRequest]) (*connect.Response[v1.SetRoleResponse], error) {
+	return c.setRole.CallUnary(ctx, req)
+}
+
+// AddStory calls proto.v1.PlanningPokerService.AddStory.
+func (c *planningPokerServiceClient) AddStory(ctx context.Context, req *connect.Request[v1.AddStoryRequest]) (*connect.Response[v1.AddStoryResponse], error) {
+	return c.addStory.CallUnary(ctx, req)
+}
+
+// ReorderStories calls proto.v1.PlanningPokerService.ReorderStories.
+func (c *planningPokerServiceClient) ReorderStories(ctx context.Context, req *connect.Request[v1.ReorderStoriesRequest]) (*connect.Response[v1.ReorderStoriesResponse], error) {
+	return c.reorderStories.CallUnary(ctx, req)
+}
+
+// RemoveStory calls proto.v1.PlanningPokerService.RemoveStory.
+func (c *planningPokerServiceClient) RemoveStory(ctx context.Context, req *connect.Request[v1.RemoveStoryRequest]) (*connect.Response[v1.RemoveStoryResponse], error) {
+	return c.removeStory.CallUnary(ctx, req)
+}
+
+// SelectStory calls proto.v1.PlanningPokerService.SelectStory.
+func (c *planningPokerServiceClient) SelectStory(ctx context.Context, req *connect.Request[v1.SelectStoryRequest]) (*connect.Response[v1.SelectStoryResponse], error) {
+	return c.selectStory.CallUnary(ctx, req)
+}
+
+// AcceptEstimate calls proto.v1.PlanningPokerService.AcceptEstimate.
+func (c *planningPokerServiceClient) AcceptEstimate(ctx context.Context, req *connect.Request[v1.AcceptEstimateRequest]) (*connect.Response[v1.AcceptEstimateResponse], error) {
+	return c.acceptEstimate.CallUnary(ctx, req)
+}
+
+// GetRoomHistory calls proto.v1.PlanningPokerService.GetRoomHistory.
+func (c *planningPokerServiceClient) GetRoomHistory(ctx context.Context, req *connect.Request[v1.GetRoomHistoryRequest]) (*connect.Response[v1.GetRoomHistoryResponse], error) {
+	return c.getRoomHistory.CallUnary(ctx, req)
+}
+
+// StartTimer calls proto.v1.PlanningPokerService.StartTimer.
+func (c *planningPokerServiceClient) StartTimer(ctx context.Context, req *connect.Request[v1.StartTimerRequest]) (*connect.Response[v1.StartTimerResponse], error) {
+	return c.startTimer.CallUnary(ctx, req)
+}
+
+// UpdateSettings calls proto.v1.PlanningPokerService.UpdateSettings.
+func (c *planningPokerServiceClient) UpdateSettings(ctx context.Context, req *connect.Request[v1.UpdateSettingsRequest]) (*connect.Response[v1.UpdateSettingsResponse], error) {
+	return c.updateSettings.CallUnary(ctx, req)
+}
+
+// LeaveRoom calls proto.v1.PlanningPokerService.LeaveRoom.
+func (c *planningPokerServiceClient) LeaveRoom(ctx context.Context, req *connect.Request[v1.LeaveRoomRequest]) (*connect.Response[v1.LeaveRoomResponse], error) {
+	return c.leaveRoom.CallUnary(ctx, req)
+}
+
+// KickParticipant calls proto.v1.PlanningPokerService.KickParticipant.
+func (c *planningPokerServiceClient) KickParticipant(ctx context.Context, req *connect.Request[v1.KickParticipantRequest]) (*connect.Response[v1.KickParticipantResponse], error) {
+	return c.kickParticipant.CallUnary(ctx, req)
+}
+
+// CloseRoom calls proto.v1.PlanningPokerService.CloseRoom.
+func (c *planningPokerServiceClient) CloseRoom(ctx context.Context, req *connect.Request[v1.CloseRoomRequest]) (*connect.Response[v1.CloseRoomResponse], error) {
+	return c.closeRoom.CallUnary(ctx, req)
+}
+
+// GetRoom calls proto.v1.PlanningPokerService.GetRoom.
+func (c *planningPokerServiceClient) GetRoom(ctx context.Context, req *connect.Request[v1.GetRoomRequest]) (*connect.Response[v1.GetRoomResponse], error) {
+	return c.getRoom.CallUnary(ctx, req)
+}
+
+// ListRooms calls proto.v1.PlanningPokerService.ListRooms.
+func (c *planningPokerServiceClient) ListRooms(ctx context.Context, req *connect.Request[v1.ListRoomsRequest]) (*connect.Response[v1.ListRoomsResponse], error) {
+	return c.listRooms.CallUnary(ctx, req)
+}
+
+// ResolveInviteCode calls proto.v1.PlanningPokerService.ResolveInviteCode.
+func (c *planningPokerServiceClient) ResolveInviteCode(ctx context.Context, req *connect.Request[v1.ResolveInviteCodeRequest]) (*connect.Response[v1.ResolveInviteCodeResponse], error) {
+	return c.resolveInviteCode.CallUnary(ctx, req)
+}
+
+// SetRoomLock calls proto.v1.PlanningPokerService.SetRoomLock.
+func (c *planningPokerServiceClient) SetRoomLock(ctx context.Context, req *connect.Request[v1.SetRoomLockRequest]) (*connect.Response[v1.SetRoomLockResponse], error) {
+	return c.setRoomLock.CallUnary(ctx, req)
+}
+
 // PlanningPokerServiceHandler is an implementation of the proto.v1.PlanningPokerService service.
 type PlanningPokerServiceHandler interface {
 	CreateRoom(context.Context, *connect.Request[v1.CreateRoomRequest], *connect.ServerStream[v1.ConnectResponse]) error
@@ -138,6 +416,46 @@ type PlanningPokerServiceHandler interface {
 	Vote(context.Context, *connect.Request[v1.VoteRequest]) (*connect.Response[v1.VoteResponse], error)
 	ShowVotes(context.Context, *connect.Request[v1.ShowVotesRequest]) (*connect.Response[v1.ShowVotesResponse], error)
 	NewGame(context.Context, *connect.Request[v1.NewGameRequest]) (*connect.Response[v1.NewGameResponse], error)
+	// Hands the facilitator role of the caller over to another participant.
+	TransferFacilitator(context.Context, *connect.Request[v1.TransferFacilitatorRequest]) (*connect.Response[v1.TransferFacilitatorResponse], error)
+	// Changes the role of a participant. Used to share the facilitator role.
+	SetRole(context.Context, *connect.Request[v1.SetRoleRequest]) (*connect.Response[v1.SetRoleResponse], error)
+	// Story backlog of the room. Only the facilitator can change it.
+	AddStory(context.Context, *connect.Request[v1.AddStoryRequest]) (*connect.Response[v1.AddStoryResponse], error)
+	// Reorders the backlog. story_ids must contain every story of the room.
+	ReorderStories(context.Context, *connect.Request[v1.ReorderStoriesRequest]) (*connect.Response[v1.ReorderStoriesResponse], error)
+	RemoveStory(context.Context, *connect.Request[v1.RemoveStoryRequest]) (*connect.Response[v1.RemoveStoryResponse], error)
+	// Makes the story the one being estimated in the current round.
+	SelectStory(context.Context, *connect.Request[v1.SelectStoryRequest]) (*connect.Response[v1.SelectStoryResponse], error)
+	// Records the final estimate agreed on for a story.
+	AcceptEstimate(context.Context, *connect.Request[v1.AcceptEstimateRequest]) (*connect.Response[v1.AcceptEstimateResponse], error)
+	// Returns the revealed rounds of the room in the order they were played.
+	GetRoomHistory(context.Context, *connect.Request[v1.GetRoomHistoryRequest]) (*connect.Response[v1.GetRoomHistoryResponse], error)
+	// Starts a countdown for the current round. Only the facilitator can start it.
+	// Starting a timer while another one is running replaces it.
+	StartTimer(context.Context, *connect.Request[v1.StartTimerRequest]) (*connect.Response[v1.StartTimerResponse], error)
+	// Changes the settings of the room listed in update_mask. Only the
+	// facilitator can change them.
+	UpdateSettings(context.Context, *connect.Request[v1.UpdateSettingsRequest]) (*connect.Response[v1.UpdateSettingsResponse], error)
+	// Leaves the room and ends the Connect stream of the caller.
+	LeaveRoom(context.Context, *connect.Request[v1.LeaveRoomRequest]) (*connect.Response[v1.LeaveRoomResponse], error)
+	// Removes a participant from the room and clears their vote. Only the
+	// facilitator can kick participants, e.g. ones who left without closing
+	// their stream.
+	KickParticipant(context.Context, *connect.Request[v1.KickParticipantRequest]) (*connect.Response[v1.KickParticipantResponse], error)
+	// Closes the room for everyone. Only the facilitator can close it.
+	CloseRoom(context.Context, *connect.Request[v1.CloseRoomRequest]) (*connect.Response[v1.CloseRoomResponse], error)
+	// Returns the current state of a room without joining it. Participants and
+	// the current story of a room with a passphrase are left out unless the
+	// caller is a participant of the room.
+	GetRoom(context.Context, *connect.Request[v1.GetRoomRequest]) (*connect.Response[v1.GetRoomResponse], error)
+	// Lists the public rooms ordered by their IDs.
+	ListRooms(context.Context, *connect.Request[v1.ListRoomsRequest]) (*connect.Response[v1.ListRoomsResponse], error)
+	// Returns the ID of the room an invite code was issued for.
+	ResolveInviteCode(context.Context, *connect.Request[v1.ResolveInviteCodeRequest]) (*connect.Response[v1.ResolveInviteCodeResponse], error)
+	// Locks or unlocks the room. A locked room refuses new participants.
+	// Only the facilitator can change it.
+	SetRoomLock(context.Context, *connect.Request[v1.SetRoomLockRequest]) (*connect.Response[v1.SetRoomLockResponse], error)
 }
 
 // NewPlanningPokerServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -171,6 +489,91 @@ func NewPlanningPokerServiceHandler(svc PlanningPokerServiceHandler, opts ...con
 		svc.NewGame,
 		opts...,
 	)
+	planningPokerServiceTransferFacilitatorHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceTransferFacilitatorProcedure,
+		svc.TransferFacilitator,
+		opts...,
+	)
+	planningPokerServiceSetRoleHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceSetRoleProcedure,
+		svc.SetRole,
+		opts...,
+	)
+	planningPokerServiceAddStoryHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceAddStoryProcedure,
+		svc.AddStory,
+		opts...,
+	)
+	planningPokerServiceReorderStoriesHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceReorderStoriesProcedure,
+		svc.ReorderStories,
+		opts...,
+	)
+	planningPokerServiceRemoveStoryHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceRemoveStoryProcedure,
+		svc.RemoveStory,
+		opts...,
+	)
+	planningPokerServiceSelectStoryHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceSelectStoryProcedure,
+		svc.SelectStory,
+		opts...,
+	)
+	planningPokerServiceAcceptEstimateHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceAcceptEstimateProcedure,
+		svc.AcceptEstimate,
+		opts...,
+	)
+	planningPokerServiceGetRoomHistoryHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceGetRoomHistoryProcedure,
+		svc.GetRoomHistory,
+		opts...,
+	)
+	planningPokerServiceStartTimerHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceStartTimerProcedure,
+		svc.StartTimer,
+		opts...,
+	)
+	planningPokerServiceUpdateSettingsHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceUpdateSettingsProcedure,
+		svc.UpdateSettings,
+		opts...,
+	)
+	planningPokerServiceLeaveRoomHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceLeaveRoomProcedure,
+		svc.LeaveRoom,
+		opts...,
+	)
+	planningPokerServiceKickParticipantHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceKickParticipantProcedure,
+		svc.KickParticipant,
+		opts...,
+	)
+	planningPokerServiceCloseRoomHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceCloseRoomProcedure,
+		svc.CloseRoom,
+		opts...,
+	)
+	planningPokerServiceGetRoomHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceGetRoomProcedure,
+		svc.GetRoom,
+		opts...,
+	)
+	planningPokerServiceListRoomsHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceListRoomsProcedure,
+		svc.ListRooms,
+		opts...,
+	)
+	planningPokerServiceResolveInviteCodeHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceResolveInviteCodeProcedure,
+		svc.ResolveInviteCode,
+		opts...,
+	)
+	planningPokerServiceSetRoomLockHandler := connect.NewUnaryHandler(
+		PlanningPokerServiceSetRoomLockProcedure,
+		svc.SetRoomLock,
+		opts...,
+	)
 	return "/proto.v1.PlanningPokerService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PlanningPokerServiceCreateRoomProcedure:
@@ -183,6 +586,40 @@ func NewPlanningPokerServiceHandler(svc PlanningPokerServiceHandler, opts ...con
 			planningPokerServiceShowVotesHandler.ServeHTTP(w, r)
 		case PlanningPokerServiceNewGameProcedure:
 			planningPokerServiceNewGameHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceTransferFacilitatorProcedure:
+			planningPokerServiceTransferFacilitatorHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceSetRoleProcedure:
+			planningPokerServiceSetRoleHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceAddStoryProcedure:
+			planningPokerServiceAddStoryHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceReorderStoriesProcedure:
+			planningPokerServiceReorderStoriesHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceRemoveStoryProcedure:
+			planningPokerServiceRemoveStoryHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceSelectStoryProcedure:
+			planningPokerServiceSelectStoryHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceAcceptEstimateProcedure:
+			planningPokerServiceAcceptEstimateHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceGetRoomHistoryProcedure:
+			planningPokerServiceGetRoomHistoryHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceStartTimerProcedure:
+			planningPokerServiceStartTimerHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceUpdateSettingsProcedure:
+			planningPokerServiceUpdateSettingsHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceLeaveRoomProcedure:
+			planningPokerServiceLeaveRoomHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceKickParticipantProcedure:
+			planningPokerServiceKickParticipantHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceCloseRoomProcedure:
+			planningPokerServiceCloseRoomHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceGetRoomProcedure:
+			planningPokerServiceGetRoomHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceListRoomsProcedure:
+			planningPokerServiceListRoomsHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceResolveInviteCodeProcedure:
+			planningPokerServiceResolveInviteCodeHandler.ServeHTTP(w, r)
+		case PlanningPokerServiceSetRoomLockProcedure:
+			planningPokerServiceSetRoomLockHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -211,3 +648,71 @@ func (UnimplementedPlanningPokerServiceHandler) ShowVotes(context.Context, *conn
 func (UnimplementedPlanningPokerServiceHandler) NewGame(context.Context, *connect.Request[v1.NewGameRequest]) (*connect.Response[v1.NewGameResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.NewGame is not implemented"))
 }
+
+func (UnimplementedPlanningPokerServiceHandler) TransferFacilitator(context.Context, *connect.Request[v1.TransferFacilitatorRequest]) (*connect.Response[v1.TransferFacilitatorResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.TransferFacilitator is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) SetRole(context.Context, *connect.Request[v1.SetRoleRequest]) (*connect.Response[v1.SetRoleResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.SetRole is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) AddStory(context.Context, *connect.Request[v1.AddStoryRequest]) (*connect.Response[v1.AddStoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.AddStory is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) ReorderStories(context.Context, *connect.Request[v1.ReorderStoriesRequest]) (*connect.Response[v1.ReorderStoriesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.ReorderStories is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) RemoveStory(context.Context, *connect.Request[v1.RemoveStoryRequest]) (*connect.Response[v1.RemoveStoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.RemoveStory is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) SelectStory(context.Context, *connect.Request[v1.SelectStoryRequest]) (*connect.Response[v1.SelectStoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.SelectStory is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) AcceptEstimate(context.Context, *connect.Request[v1.AcceptEstimateRequest]) (*connect.Response[v1.AcceptEstimateResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.AcceptEstimate is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) GetRoomHistory(context.Context, *connect.Request[v1.GetRoomHistoryRequest]) (*connect.Response[v1.GetRoomHistoryResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.GetRoomHistory is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) StartTimer(context.Context, *connect.Request[v1.StartTimerRequest]) (*connect.Response[v1.StartTimerResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.StartTimer is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) UpdateSettings(context.Context, *connect.Request[v1.UpdateSettingsRequest]) (*connect.Response[v1.UpdateSettingsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.UpdateSettings is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) LeaveRoom(context.Context, *connect.Request[v1.LeaveRoomRequest]) (*connect.Response[v1.LeaveRoomResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.LeaveRoom is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) KickParticipant(context.Context, *connect.Request[v1.KickParticipantRequest]) (*connect.Response[v1.KickParticipantResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.KickParticipant is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) CloseRoom(context.Context, *connect.Request[v1.CloseRoomRequest]) (*connect.Response[v1.CloseRoomResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.CloseRoom is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) GetRoom(context.Context, *connect.Request[v1.GetRoomRequest]) (*connect.Response[v1.GetRoomResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.GetRoom is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) ListRooms(context.Context, *connect.Request[v1.ListRoomsRequest]) (*connect.Response[v1.ListRoomsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.ListRooms is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) ResolveInviteCode(context.Context, *connect.Request[v1.ResolveInviteCodeRequest]) (*connect.Response[v1.ResolveInviteCodeResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.ResolveInviteCode is not implemented"))
+}
+
+func (UnimplementedPlanningPokerServiceHandler) SetRoomLock(context.Context, *connect.Request[v1.SetRoomLockRequest]) (*connect.Response[v1.SetRoomLockResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("proto.v1.PlanningPokerService.SetRoomLock is not implemented"))
+}
@@ -0,0 +1,105 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"connectrpc.com/connect"
+	"golang.org/x/crypto/bcrypt"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+// maxPassphraseLength bcryptで扱える合言葉の最大のバイト数
+const maxPassphraseLength = 72
+
+var (
+	ErrPassphraseTooLong = errors.New("passphrase must be at most 72 bytes")
+	ErrWrongPassphrase   = errors.New("wrong passphrase")
+	ErrRoomLocked        = errors.New("the room is locked")
+	ErrRoomFull          = errors.New("the number of participants reached the limit")
+)
+
+// hashPassphrase 合言葉をストアに保存するためのハッシュにする。合言葉が空の場合は空文字列を返す。
+func hashPassphrase(passphrase string) (string, error) {
+	if passphrase == "" {
+		return "", nil
+	}
+	if len(passphrase) > maxPassphraseLength {
+		return "", ErrPassphraseTooLong
+	}
+	hash, err := bcrypt.GenerateFromPassword([]byte(passphrase), bcrypt.DefaultCost)
+	if err != nil {
+		return "", err
+	}
+	return string(hash), nil
+}
+
+// checkPassphrase passphraseがルームの合言葉と一致するかどうかを返す。合言葉のないルームでは常に真。
+func (r *RoomRecord) checkPassphrase(passphrase string) bool {
+	if r.PassphraseHash == "" {
+		return true
+	}
+	return bcrypt.CompareHashAndPassword([]byte(r.PassphraseHash), []byte(passphrase)) == nil
+}
+
+// admit 新しく参加するクライアントを受け入れてよいかを確かめる。
+// 他のインスタンスに同じ名前で接続している参加者がいる場合はErrAlreadyConnectedを、
+// 他のインスタンスも含めて接続中の参加者が上限に達している場合はErrRoomFullを、
+// ロックされたルームにはErrRoomLockedを、合言葉を確認できていない場合はErrWrongPassphraseを返す。
+func (st *roomState) admit(ctx context.Context, join joinRequest) error {
+	record, err := st.store.GetRoom(ctx, st.id)
+	if err != nil {
+		return err
+	}
+	if record.hasParticipant(join.name) {
+		return ErrAlreadyConnected
+	}
+	if st.maxParticipants > 0 && len(record.Participants) >= st.maxParticipants {
+		return ErrRoomFull
+	}
+	if record.Locked {
+		return ErrRoomLocked
+	}
+	// ストアから読み直した時点で合言葉が設定されていなければ、確認は不要
+	if record.PassphraseHash != "" && !join.admitted {
+		return ErrWrongPassphrase
+	}
+	return nil
+}
+
+func (s *pokerServer) SetRoomLock(ctx context.Context, req *connect.Request[pokerv1.SetRoomLockRequest]) (*connect.Response[pokerv1.SetRoomLockResponse], error) {
+	loggerFrom(ctx).Debug("SetRoomLock function was invoked", "locked", req.Msg.Locked)
+
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		record, err := st.authorizeFacilitator(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+		if record.Locked == req.Msg.Locked {
+			return nil
+		}
+		if err := st.store.SetLocked(ctx, st.id, req.Msg.Locked); err != nil {
+			return roomNotFoundOr(st.id, err)
+		}
+		st.broadcast(newRoomLockChangedEvent(req.Msg.Locked, req.Msg.Id))
+		st.touch(ctx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pokerv1.SetRoomLockResponse{Locked: req.Msg.Locked}), nil
+}
+
+func newRoomLockChangedEvent(locked bool, changedBy string) *pokerv1.ConnectResponse {
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_LOCK_CHANGED,
+		Message: strconv.FormatBool(locked),
+		Event: &pokerv1.ConnectResponse_RoomLockChanged{
+			RoomLockChanged: &pokerv1.RoomLockChanged{Locked: locked, ChangedBy: changedBy},
+		},
+	}
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+// busTimeout バスへの配信や購読を待つ時間
+const busTimeout = time.Second
+
+// Bus ルームのイベントを、同じストアを共有するサーバのインスタンスの間で配るための仕組み。
+// 各インスタンスは接続を管理しているルームを購読し、他のインスタンスが配ったイベントを自分のクライアントに届ける。
+// 同じルームに配られたメッセージは、購読している全てのインスタンスに配られた順に届く必要がある。
+// セッショントークンはストリームを受け持つインスタンスしか知らないので、ストリーム以外のRPCは、
+// ロードバランサで呼び出し元のストリームと同じインスタンスに振り分ける必要がある。
+type Bus interface {
+	// Publish roomIdのルームを購読している全てのインスタンスにmsgを配る
+	Publish(ctx context.Context, roomId string, msg []byte) error
+	// Subscribe roomIdのルームに配られたメッセージを、届いた順にdeliverに渡す。
+	// deliverは購読ごとのゴルーチンから呼ばれる。戻り値の関数を呼ぶと購読をやめる。
+	Subscribe(ctx context.Context, roomId string, deliver func(msg []byte)) (func(), error)
+	// Ping メッセージを配れる状態かを確かめる
+	Ping(ctx context.Context) error
+	Close() error
+}
+
+// busMessage バスで配るイベント。
+// Originは配ったインスタンスで、自分が配ったものは受け取っても無視する。
+// Loggedが真のイベントは、受け取ったインスタンスでもシーケンス番号を振ってイベントログに残す。
+// シーケンス番号はインスタンスごとに振るので、Eventに含まれる番号は使わない。
+type busMessage struct {
+	Origin string          `json:"origin"`
+	Logged bool            `json:"logged"`
+	Event  json.RawMessage `json:"event"`
+}
+
+// publishEvent resをoriginのインスタンスが配ったイベントとして、busでroomIdのルームに配る
+func publishEvent(ctx context.Context, bus Bus, origin, roomId string, res *pokerv1.ConnectResponse, logged bool) error {
+	event, err := protojson.Marshal(res)
+	if err != nil {
+		return err
+	}
+	msg, err := json.Marshal(busMessage{Origin: origin, Logged: logged, Event: event})
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(ctx, busTimeout)
+	defer cancel()
+	return bus.Publish(ctx, roomId, msg)
+}
+
+// decodeBusMessage バスから受け取ったメッセージを読む
+func decodeBusMessage(b []byte) (busMessage, *pokerv1.ConnectResponse, error) {
+	var msg busMessage
+	if err := json.Unmarshal(b, &msg); err != nil {
+		return busMessage{}, nil, err
+	}
+	res := &pokerv1.ConnectResponse{}
+	if err := protojson.Unmarshal(msg.Event, res); err != nil {
+		return busMessage{}, nil, err
+	}
+	return msg, res, nil
+}
+
+// localBusQueueSize 購読ごとに、まだ渡していないメッセージを溜めておける件数
+const localBusQueueSize = 256
+
+var ErrBusClosed = errors.New("bus is closed")
+
+// localBus 同じプロセスの中だけで配るBus。
+// インスタンスが1つの場合や、テストで複数のサーバを1つのプロセスで動かす場合に使う。
+// 配る側を待たせないように購読ごとのゴルーチンからdeliverを呼び、溜まりすぎたメッセージは捨てる。
+type localBus struct {
+	mu     sync.Mutex
+	nextId int
+	subs   map[string]map[int]*localSubscription
+	closed bool
+}
+
+type localSubscription struct {
+	queue chan []byte
+	done  chan struct{}
+}
+
+func NewLocalBus() *localBus {
+	return &localBus{
+		subs: make(map[string]map[int]*localSubscription),
+	}
+}
+
+func (b *localBus) Publish(_ context.Context, roomId string, msg []byte) error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.closed {
+		return ErrBusClosed
+	}
+	for _, sub := range b.subs[roomId] {
+		select {
+		case sub.queue <- msg:
+		default:
+			slog.Warn("bus subscriber is too slow, message is dropped", "room_id", roomId)
+		}
+	}
+	return nil
+}
+
+func (b *localBus) Subscribe(_ context.Context, roomId string, deliver func(msg []byte)) (func(), error) {
+	sub := &localSubscription{
+		queue: make(chan []byte, localBusQueueSize),
+		done:  make(chan struct{}),
+	}
+	b.mu.Lock()
+	if b.closed {
+		b.mu.Unlock()
+		return nil, ErrBusClosed
+	}
+	id := b.nextId
+	b.nextId++
+	if b.subs[roomId] == nil {
+		b.subs[roomId] = make(map[int]*localSubscription)
+	}
+	b.subs[roomId][id] = sub
+	b.mu.Unlock()
+
+	go func() {
+		for {
+			select {
+			case msg := <-sub.queue:
+				deliver(msg)
+			case <-sub.done:
+				return
+			}
+		}
+	}()
+
+	var once sync.Once
+	return func() {
+		once.Do(func() {
+			b.mu.Lock()
+			delete(b.subs[roomId], id)
+			if len(b.subs[roomId]) == 0 {
+				delete(b.subs, roomId)
+			}
+			b.mu.Unlock()
+			close(sub.done)
+		})
+	}, nil
+}
+
+func (b *localBus) Ping(_ context.Context) error {
+	return nil
+}
+
+func (b *localBus) Close() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	b.closed = true
+	return nil
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"context"
+
+	"github.com/redis/go-redis/v9"
+)
+
+// redisChannelPrefix ルームのイベントを配るRedisのチャネル名の接頭辞
+const redisChannelPrefix = "planning-poker:room:"
+
+// redisBus RedisのPub/Subで、複数のインスタンスにイベントを配るBus。
+// ValkeyなどのRedisと互換性のあるサーバでも使える。
+// Pub/Subは購読していない間のメッセージを残さないので、Redisとの接続が切れている間に配られたイベントは届かない。
+type redisBus struct {
+	client *redis.Client
+}
+
+// NewRedisBus redis://[:password@]host:port/db の形式のurlで指定されたサーバを使うBusを返す
+func NewRedisBus(url string) (*redisBus, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	return &redisBus{client: redis.NewClient(opts)}, nil
+}
+
+func (b *redisBus) Publish(ctx context.Context, roomId string, msg []byte) error {
+	return b.client.Publish(ctx, redisChannelPrefix+roomId, msg).Err()
+}
+
+// Subscribe ルームごとにRedisとの接続を一つ使って購読する
+func (b *redisBus) Subscribe(ctx context.Context, roomId string, deliver func(msg []byte)) (func(), error) {
+	pubsub := b.client.Subscribe(ctx, redisChannelPrefix+roomId)
+	// 購読が始まったことを確かめてから返す。そうしないと、直後に配られたメッセージを取りこぼす
+	if _, err := pubsub.Receive(ctx); err != nil {
+		pubsub.Close()
+		return nil, err
+	}
+	ch := pubsub.Channel()
+	go func() {
+		for msg := range ch {
+			deliver([]byte(msg.Payload))
+		}
+	}()
+	return func() { pubsub.Close() }, nil
+}
+
+func (b *redisBus) Ping(ctx context.Context) error {
+	return b.client.Ping(ctx).Err()
+}
+
+func (b *redisBus) Close() error {
+	return b.client.Close()
+}
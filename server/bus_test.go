@@ -0,0 +1,101 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+)
+
+func TestBus(t *testing.T) {
+	buses := map[string]func(t *testing.T) Bus{
+		"local": func(t *testing.T) Bus {
+			return NewLocalBus()
+		},
+		"redis": func(t *testing.T) Bus {
+			s := miniredis.RunT(t)
+			bus, err := NewRedisBus("redis://" + s.Addr())
+			if err != nil {
+				t.Fatal(err)
+			}
+			return bus
+		},
+	}
+	for name, newBus := range buses {
+		t.Run(name, func(t *testing.T) {
+			bus := newBus(t)
+			defer bus.Close()
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if err := bus.Ping(ctx); err != nil {
+				t.Fatal(err)
+			}
+
+			// 同じルームを購読している全員に、配った順に届く
+			const n = 10
+			var received [2]chan string
+			var unsubscribes [2]func()
+			for i := range received {
+				ch := make(chan string, n)
+				unsubscribe, err := bus.Subscribe(ctx, "room", func(msg []byte) { ch <- string(msg) })
+				if err != nil {
+					t.Fatal(err)
+				}
+				received[i] = ch
+				unsubscribes[i] = unsubscribe
+			}
+			other := make(chan string, n)
+			unsubscribeOther, err := bus.Subscribe(ctx, "other", func(msg []byte) { other <- string(msg) })
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer unsubscribeOther()
+
+			for i := 0; i < n; i++ {
+				if err := bus.Publish(ctx, "room", []byte(fmt.Sprint(i))); err != nil {
+					t.Fatal(err)
+				}
+			}
+			for _, ch := range received {
+				for i := 0; i < n; i++ {
+					select {
+					case msg := <-ch:
+						if msg != fmt.Sprint(i) {
+							t.Fatalf("expected %d, got %s", i, msg)
+						}
+					case <-ctx.Done():
+						t.Fatal("timed out waiting for a message")
+					}
+				}
+			}
+			select {
+			case msg := <-other:
+				t.Fatalf("message for another room is delivered: %s", msg)
+			default:
+			}
+
+			// 購読をやめると届かなくなる
+			unsubscribes[0]()
+			if err := bus.Publish(ctx, "room", []byte("last")); err != nil {
+				t.Fatal(err)
+			}
+			select {
+			case msg := <-received[1]:
+				if msg != "last" {
+					t.Fatalf("unexpected message %s", msg)
+				}
+			case <-ctx.Done():
+				t.Fatal("timed out waiting for a message")
+			}
+			select {
+			case msg := <-received[0]:
+				t.Fatalf("message is delivered after unsubscribing: %s", msg)
+			case <-time.After(100 * time.Millisecond):
+			}
+			unsubscribes[1]()
+		})
+	}
+}
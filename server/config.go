@@ -0,0 +1,334 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"gopkg.in/yaml.v3"
+)
+
+// envPrefix サーバの設定を読む環境変数の接頭辞
+const envPrefix = "PLANNING_POKER_"
+
+// Config サーバの設定。
+// 既定値、設定ファイル、環境変数、コマンドラインフラグの順に読み、後のものほど優先する。
+// MaxRoomsとMaxParticipantsが0の場合は、上限を設けない。
+// TLSCertFileとTLSKeyFileが指定された場合はTLSで、そうでなければ平文で待ち受ける。
+type Config struct {
+	ListenAddr      string        `yaml:"listen_addr"`
+	AllowedOrigins  []string      `yaml:"allowed_origins"`
+	IdleTimeout     time.Duration `yaml:"idle_timeout"`
+	SweepInterval   time.Duration `yaml:"sweep_interval"`
+	ShutdownTimeout time.Duration `yaml:"shutdown_timeout"`
+	MaxRooms        int           `yaml:"max_rooms"`
+	MaxParticipants int           `yaml:"max_participants"`
+	QueueSize       int           `yaml:"queue_size"`
+	StoreFile       string        `yaml:"store_file"`
+	StoreURL        string        `yaml:"store_url"`
+	BusURL          string        `yaml:"bus_url"`
+	TLSCertFile     string        `yaml:"tls_cert_file"`
+	TLSKeyFile      string        `yaml:"tls_key_file"`
+	LogLevel        string        `yaml:"log_level"`
+	LogFormat       string        `yaml:"log_format"`
+}
+
+// defaultConfig 何も指定されなかった場合の設定
+func defaultConfig() Config {
+	return Config{
+		ListenAddr:      ":8080",
+		AllowedOrigins:  []string{"*"},
+		IdleTimeout:     6 * time.Hour,
+		SweepInterval:   1 * time.Hour,
+		ShutdownTimeout: 10 * time.Second,
+		QueueSize:       DefaultQueueSize,
+		LogLevel:        "info",
+		LogFormat:       "json",
+	}
+}
+
+// configOption フラグと環境変数から設定できる項目。
+// フラグの名前をハイフンの代わりにアンダースコアにして大文字にし、envPrefixを付けたものが環境変数の名前になる。
+type configOption struct {
+	name  string
+	usage string
+	set   func(c *Config, v string) error
+}
+
+var configOptions = []configOption{
+	{"listen-addr", "address to listen on", func(c *Config, v string) error {
+		c.ListenAddr = v
+		return nil
+	}},
+	{"allowed-origins", "comma separated origins allowed by CORS", func(c *Config, v string) error {
+		c.AllowedOrigins = splitList(v)
+		return nil
+	}},
+	{"idle-timeout", "rooms not used for this duration are closed", func(c *Config, v string) error {
+		return parseDuration(&c.IdleTimeout, v)
+	}},
+	{"sweep-interval", "interval to look for rooms not used for idle-timeout", func(c *Config, v string) error {
+		return parseDuration(&c.SweepInterval, v)
+	}},
+	{"shutdown-timeout", "time to wait for in-flight requests when the server is stopped", func(c *Config, v string) error {
+		return parseDuration(&c.ShutdownTimeout, v)
+	}},
+	{"max-rooms", "maximum number of rooms. unlimited if 0", func(c *Config, v string) error {
+		return parseInt(&c.MaxRooms, v)
+	}},
+	{"max-participants", "maximum number of participants connected to a room. unlimited if 0", func(c *Config, v string) error {
+		return parseInt(&c.MaxParticipants, v)
+	}},
+	{"queue-size", "number of events buffered for each participant. participants falling further behind are disconnected", func(c *Config, v string) error {
+		return parseInt(&c.QueueSize, v)
+	}},
+	{"store-file", "path of the file to persist rooms. rooms are kept only in memory if empty", func(c *Config, v string) error {
+		c.StoreFile = v
+		return nil
+	}},
+	{"store-url", "URL of the Redis compatible server to store rooms in, like redis://localhost:6379/0. instances storing rooms in the same server can serve the same rooms. cannot be used with store-file", func(c *Config, v string) error {
+		c.StoreURL = v
+		return nil
+	}},
+	{"bus-url", "URL of the Redis compatible server to share room events with other instances sharing the store, like redis://localhost:6379/0. requires store-url. events are shared only in this process if empty", func(c *Config, v string) error {
+		c.BusURL = v
+		return nil
+	}},
+	{"tls-cert-file", "path of the TLS certificate. reloaded when the file is changed", func(c *Config, v string) error {
+		c.TLSCertFile = v
+		return nil
+	}},
+	{"tls-key-file", "path of the TLS private key. reloaded when the file is changed", func(c *Config, v string) error {
+		c.TLSKeyFile = v
+		return nil
+	}},
+	{"log-level", "minimum level of logs. debug, info, warn or error", func(c *Config, v string) error {
+		c.LogLevel = v
+		return nil
+	}},
+	{"log-format", "format of logs. json or text", func(c *Config, v string) error {
+		c.LogFormat = v
+		return nil
+	}},
+}
+
+// envName フラグの名前に対応する環境変数の名前を返す
+func envName(flagName string) string {
+	return envPrefix + strings.ToUpper(strings.ReplaceAll(flagName, "-", "_"))
+}
+
+// loadConfig argsのフラグ、lookupEnvで引ける環境変数、-configかPLANNING_POKER_CONFIGで指定された設定ファイルから設定を読み、検証する
+func loadConfig(args []string, lookupEnv func(string) (string, bool)) (Config, error) {
+	defaults := defaultConfig()
+	fs := flag.NewFlagSet("server", flag.ContinueOnError)
+	configFile := fs.String("config", "", "path of the YAML config file. also read from "+envName("config"))
+
+	// フラグは環境変数より優先するので、全て読んでから適用する
+	type flagValue struct {
+		option configOption
+		value  string
+	}
+	var flags []flagValue
+	for _, o := range configOptions {
+		o := o
+		usage := fmt.Sprintf("%s (env %s)", o.usage, envName(o.name))
+		if d := defaults.value(o.name); d != "" {
+			usage = fmt.Sprintf("%s (env %s, default %s)", o.usage, envName(o.name), d)
+		}
+		fs.Func(o.name, usage, func(v string) error {
+			flags = append(flags, flagValue{o, v})
+			return nil
+		})
+	}
+	if err := fs.Parse(args); err != nil {
+		return Config{}, err
+	}
+
+	cfg := defaults
+	path := *configFile
+	if path == "" {
+		path, _ = lookupEnv(envName("config"))
+	}
+	if path != "" {
+		if err := cfg.readFile(path); err != nil {
+			return Config{}, err
+		}
+	}
+	for _, o := range configOptions {
+		v, ok := lookupEnv(envName(o.name))
+		if !ok {
+			continue
+		}
+		if err := o.set(&cfg, v); err != nil {
+			return Config{}, fmt.Errorf("%s: %w", envName(o.name), err)
+		}
+	}
+	for _, f := range flags {
+		if err := f.option.set(&cfg, f.value); err != nil {
+			return Config{}, fmt.Errorf("-%s: %w", f.option.name, err)
+		}
+	}
+
+	return cfg, cfg.validate()
+}
+
+// readFile pathのYAMLの設定ファイルで、書かれている項目だけを上書きする。知らない項目はエラーにする。
+func (c *Config) readFile(path string) error {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return err
+	}
+	dec := yaml.NewDecoder(bytes.NewReader(b))
+	dec.KnownFields(true)
+	if err := dec.Decode(c); err != nil && !errors.Is(err, io.EOF) {
+		return fmt.Errorf("%s: %w", path, err)
+	}
+	return nil
+}
+
+// validate 設定の値が正しいかを確かめ、誤りを全てまとめて返す
+func (c Config) validate() error {
+	var errs []error
+	if c.ListenAddr == "" {
+		errs = append(errs, errors.New("listen-addr must not be empty"))
+	}
+	if len(c.AllowedOrigins) == 0 {
+		errs = append(errs, errors.New("allowed-origins must not be empty"))
+	}
+	if c.IdleTimeout <= 0 {
+		errs = append(errs, errors.New("idle-timeout must be positive"))
+	}
+	if c.SweepInterval <= 0 {
+		errs = append(errs, errors.New("sweep-interval must be positive"))
+	}
+	if c.ShutdownTimeout <= 0 {
+		errs = append(errs, errors.New("shutdown-timeout must be positive"))
+	}
+	if c.MaxRooms < 0 {
+		errs = append(errs, errors.New("max-rooms must not be negative"))
+	}
+	if c.MaxParticipants < 0 {
+		errs = append(errs, errors.New("max-participants must not be negative"))
+	}
+	if c.QueueSize < 1 {
+		errs = append(errs, errors.New("queue-size must be positive"))
+	}
+	if c.StoreURL != "" {
+		if _, err := redis.ParseURL(c.StoreURL); err != nil {
+			errs = append(errs, fmt.Errorf("store-url: %w", err))
+		}
+		if c.StoreFile != "" {
+			errs = append(errs, errors.New("store-file and store-url cannot be specified together"))
+		}
+	}
+	if c.BusURL != "" {
+		if _, err := redis.ParseURL(c.BusURL); err != nil {
+			errs = append(errs, fmt.Errorf("bus-url: %w", err))
+		}
+		// イベントだけを共有しても、ルームの状態はインスタンスごとに食い違う
+		switch {
+		case c.StoreFile != "":
+			errs = append(errs, errors.New("bus-url cannot be used with store-file, which cannot be shared with other instances. use store-url"))
+		case c.StoreURL == "":
+			errs = append(errs, errors.New("bus-url requires store-url to share rooms with other instances"))
+		}
+	}
+	if (c.TLSCertFile == "") != (c.TLSKeyFile == "") {
+		errs = append(errs, errors.New("tls-cert-file and tls-key-file must be specified together"))
+	}
+	if _, err := newLogger(io.Discard, c.LogLevel, c.LogFormat); err != nil {
+		errs = append(errs, err)
+	}
+	return errors.Join(errs...)
+}
+
+// value フラグの名前に対応する設定の値を、フラグで指定する形式で返す
+func (c Config) value(name string) string {
+	switch name {
+	case "listen-addr":
+		return c.ListenAddr
+	case "allowed-origins":
+		return strings.Join(c.AllowedOrigins, ",")
+	case "idle-timeout":
+		return c.IdleTimeout.String()
+	case "sweep-interval":
+		return c.SweepInterval.String()
+	case "shutdown-timeout":
+		return c.ShutdownTimeout.String()
+	case "max-rooms":
+		return strconv.Itoa(c.MaxRooms)
+	case "max-participants":
+		return strconv.Itoa(c.MaxParticipants)
+	case "queue-size":
+		return strconv.Itoa(c.QueueSize)
+	case "store-file":
+		return c.StoreFile
+	case "store-url":
+		return redactURL(c.StoreURL)
+	case "bus-url":
+		return redactURL(c.BusURL)
+	case "tls-cert-file":
+		return c.TLSCertFile
+	case "tls-key-file":
+		return c.TLSKeyFile
+	case "log-level":
+		return c.LogLevel
+	case "log-format":
+		return c.LogFormat
+	}
+	return ""
+}
+
+// LogValue 起動時に、実際に使う設定をログに出すための表現
+func (c Config) LogValue() slog.Value {
+	attrs := make([]slog.Attr, 0, len(configOptions))
+	for _, o := range configOptions {
+		attrs = append(attrs, slog.String(strings.ReplaceAll(o.name, "-", "_"), c.value(o.name)))
+	}
+	return slog.GroupValue(attrs...)
+}
+
+func splitList(v string) []string {
+	var list []string
+	for _, s := range strings.Split(v, ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			list = append(list, s)
+		}
+	}
+	return list
+}
+
+func parseDuration(d *time.Duration, v string) error {
+	parsed, err := time.ParseDuration(v)
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+func parseInt(n *int, v string) error {
+	parsed, err := strconv.Atoi(v)
+	if err != nil {
+		return err
+	}
+	*n = parsed
+	return nil
+}
+
+// redactURL パスワードを含む場合があるので、ログに出さないようにurlのパスワードを伏せる
+func redactURL(v string) string {
+	if u, err := url.Parse(v); err == nil {
+		return u.Redacted()
+	}
+	return v
+}
@@ -0,0 +1,95 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestLoadConfig(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "server.yaml")
+	err := os.WriteFile(path, []byte(`
+listen_addr: ":9000"
+allowed_origins: ["https://poker.example.com"]
+idle_timeout: 2h
+max_rooms: 10
+log_format: text
+`), 0o600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	env := map[string]string{
+		"PLANNING_POKER_CONFIG":     path,
+		"PLANNING_POKER_MAX_ROOMS":  "20",
+		"PLANNING_POKER_LOG_LEVEL":  "debug",
+		"PLANNING_POKER_QUEUE_SIZE": "32",
+	}
+	lookupEnv := func(key string) (string, bool) {
+		v, ok := env[key]
+		return v, ok
+	}
+
+	// 既定値、設定ファイル、環境変数、フラグの順に優先する
+	cfg, err := loadConfig([]string{"-queue-size", "64", "-allowed-origins", "https://a.example.com, https://b.example.com"}, lookupEnv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := defaultConfig()
+	want.ListenAddr = ":9000"
+	want.AllowedOrigins = []string{"https://a.example.com", "https://b.example.com"}
+	want.IdleTimeout = 2 * time.Hour
+	want.MaxRooms = 20
+	want.QueueSize = 64
+	want.LogLevel = "debug"
+	want.LogFormat = "text"
+	if cfg.LogValue().String() != want.LogValue().String() {
+		t.Fatalf("expected %v, got %v", want.LogValue(), cfg.LogValue())
+	}
+
+	// 誤りはまとめて報告する
+	_, err = loadConfig([]string{"-queue-size", "0", "-sweep-interval", "-1s", "-log-format", "xml"}, func(string) (string, bool) { return "", false })
+	if err == nil {
+		t.Fatal("expected validation errors")
+	}
+	for _, want := range []string{"queue-size", "sweep-interval", "log format"} {
+		if !strings.Contains(err.Error(), want) {
+			t.Errorf("error does not mention %s: %v", want, err)
+		}
+	}
+
+	// バスでイベントを共有するなら、ルームの状態も共有する必要がある
+	noEnv := func(string) (string, bool) { return "", false }
+	for _, args := range [][]string{
+		{"-bus-url", "redis://localhost:6379/0"},
+		{"-bus-url", "redis://localhost:6379/0", "-store-file", "rooms.db"},
+		{"-store-url", "redis://localhost:6379/0", "-store-file", "rooms.db"},
+	} {
+		if _, err := loadConfig(args, noEnv); err == nil {
+			t.Errorf("expected an error for %v", args)
+		}
+	}
+	if _, err := loadConfig([]string{"-bus-url", "redis://localhost:6379/0", "-store-url", "redis://localhost:6379/0"}, noEnv); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := loadConfig([]string{"-max-rooms", "many"}, lookupEnv); err == nil || !strings.Contains(err.Error(), "-max-rooms") {
+		t.Fatalf("expected an error for -max-rooms, got %v", err)
+	}
+	// 例の設定ファイルは既定値と同じ
+	cfg, err = loadConfig([]string{"-config", "config.example.yaml"}, func(string) (string, bool) { return "", false })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.LogValue().String() != defaultConfig().LogValue().String() {
+		t.Fatalf("example config differs from the defaults: %v", cfg.LogValue())
+	}
+
+	if err := os.WriteFile(path, []byte("listen_address: \":9000\"\n"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadConfig(nil, lookupEnv); err == nil {
+		t.Fatal("expected an error for an unknown field")
+	}
+}
@@ -0,0 +1,159 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"unicode/utf8"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+const (
+	// maxDeckSize カスタムデッキに含められるカードの最大枚数
+	maxDeckSize = 32
+	// maxCardLabelLength カードのラベルの最大文字数
+	maxCardLabelLength = 16
+)
+
+var (
+	ErrEmptyDeck      = errors.New("custom deck must have at least one card")
+	ErrCardNotInDeck  = errors.New("this card is not in the deck")
+	ErrInvalidCard    = errors.New("invalid card")
+	ErrDuplicatedCard = errors.New("card labels must be unique")
+)
+
+// Card デッキに含まれる1枚のカード。
+// Valueがnilのカード("?"やTシャツサイズなど)は公開はされるが、統計の計算には使わない。
+type Card struct {
+	Label string   `json:"label"`
+	Value *float64 `json:"value,omitempty"`
+}
+
+// Deck ルームで使うカードの組。Cardsはデッキ上の並び順を保持する。
+type Deck struct {
+	Preset pokerv1.DeckPreset `json:"preset"`
+	Cards  []Card             `json:"cards"`
+}
+
+func numberCard(label string, value float64) Card {
+	return Card{Label: label, Value: &value}
+}
+
+func labelCard(label string) Card {
+	return Card{Label: label}
+}
+
+var (
+	questionCard = labelCard("?")
+	coffeeCard   = labelCard("☕")
+)
+
+var presetDecks = map[pokerv1.DeckPreset][]Card{
+	pokerv1.DeckPreset_DECK_PRESET_FIBONACCI: {
+		numberCard("0", 0), numberCard("1", 1), numberCard("2", 2), numberCard("3", 3),
+		numberCard("5", 5), numberCard("8", 8), numberCard("13", 13), numberCard("21", 21),
+		numberCard("34", 34), numberCard("55", 55), numberCard("89", 89),
+		questionCard, coffeeCard,
+	},
+	pokerv1.DeckPreset_DECK_PRESET_MODIFIED_FIBONACCI: {
+		numberCard("0", 0), numberCard("½", 0.5), numberCard("1", 1), numberCard("2", 2),
+		numberCard("3", 3), numberCard("5", 5), numberCard("8", 8), numberCard("13", 13),
+		numberCard("20", 20), numberCard("40", 40), numberCard("100", 100),
+		questionCard, coffeeCard,
+	},
+	pokerv1.DeckPreset_DECK_PRESET_T_SHIRT: {
+		labelCard("XS"), labelCard("S"), labelCard("M"), labelCard("L"), labelCard("XL"), labelCard("XXL"),
+		questionCard, coffeeCard,
+	},
+	pokerv1.DeckPreset_DECK_PRESET_POWERS_OF_TWO: {
+		numberCard("0", 0), numberCard("1", 1), numberCard("2", 2), numberCard("4", 4),
+		numberCard("8", 8), numberCard("16", 16), numberCard("32", 32), numberCard("64", 64),
+		questionCard, coffeeCard,
+	},
+}
+
+// defaultDeck デッキが指定されなかった場合に使うデッキ
+func defaultDeck() Deck {
+	return Deck{
+		Preset: pokerv1.DeckPreset_DECK_PRESET_FIBONACCI,
+		Cards:  presetDecks[pokerv1.DeckPreset_DECK_PRESET_FIBONACCI],
+	}
+}
+
+// deckFromProto リクエストで指定されたデッキを検証して返す。
+// プリセットが指定された場合はcardsを無視し、プリセットのカードを使う。
+func deckFromProto(d *pokerv1.Deck) (Deck, error) {
+	if d == nil || (d.Preset == pokerv1.DeckPreset_DECK_PRESET_UNSPECIFIED && len(d.Cards) == 0) {
+		return defaultDeck(), nil
+	}
+
+	if d.Preset != pokerv1.DeckPreset_DECK_PRESET_CUSTOM && d.Preset != pokerv1.DeckPreset_DECK_PRESET_UNSPECIFIED {
+		cards, ok := presetDecks[d.Preset]
+		if !ok {
+			return Deck{}, fmt.Errorf("unknown deck preset %v", d.Preset)
+		}
+		return Deck{Preset: d.Preset, Cards: cards}, nil
+	}
+
+	if len(d.Cards) == 0 {
+		return Deck{}, ErrEmptyDeck
+	}
+	if len(d.Cards) > maxDeckSize {
+		return Deck{}, fmt.Errorf("deck must have at most %d cards", maxDeckSize)
+	}
+
+	cards := make([]Card, 0, len(d.Cards))
+	seen := make(map[string]bool, len(d.Cards))
+	for _, c := range d.Cards {
+		if c.Label == "" || utf8.RuneCountInString(c.Label) > maxCardLabelLength {
+			return Deck{}, fmt.Errorf("%w: label must be 1 to %d characters", ErrInvalidCard, maxCardLabelLength)
+		}
+		if seen[c.Label] {
+			return Deck{}, fmt.Errorf("%w: %s", ErrDuplicatedCard, c.Label)
+		}
+		seen[c.Label] = true
+
+		card := Card{Label: c.Label}
+		if c.Value != nil {
+			if math.IsNaN(*c.Value) || math.IsInf(*c.Value, 0) {
+				return Deck{}, fmt.Errorf("%w: value of %s must be finite", ErrInvalidCard, c.Label)
+			}
+			v := *c.Value
+			card.Value = &v
+		}
+		cards = append(cards, card)
+	}
+	return Deck{Preset: pokerv1.DeckPreset_DECK_PRESET_CUSTOM, Cards: cards}, nil
+}
+
+func (d Deck) toProto() *pokerv1.Deck {
+	cards := make([]*pokerv1.Card, 0, len(d.Cards))
+	for _, c := range d.Cards {
+		cards = append(cards, &pokerv1.Card{Label: c.Label, Value: c.Value})
+	}
+	return &pokerv1.Deck{Preset: d.Preset, Cards: cards}
+}
+
+// find labelのカードと、そのデッキ上の位置を返す
+func (d Deck) find(label string) (Card, int, bool) {
+	for i, c := range d.Cards {
+		if c.Label == label {
+			return c, i, true
+		}
+	}
+	return Card{}, -1, false
+}
+
+// cardLabel VoteRequestから投票するカードのラベルを取り出す。
+// cardを送ってこない古いクライアントのために、正の整数のvoteは同じラベルのカードとして扱う。
+func cardLabel(req *pokerv1.VoteRequest) (string, error) {
+	if req.Card != "" {
+		return req.Card, nil
+	}
+	if req.Vote > 0 {
+		return strconv.Itoa(int(req.Vote)), nil
+	}
+	return "", fmt.Errorf("invalid vote %d", req.Vote)
+}
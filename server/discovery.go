@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"sort"
+	"strings"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+const (
+	defaultPageSize = 20
+	maxPageSize     = 100
+)
+
+var ErrInvalidPageToken = errors.New("invalid page token")
+
+// roomInfo ルームの状態を、ストリームを開かずに参照できる形で返す。
+// 接続の管理を始めているルームは、ストリームのハンドラと同じくルームのゴルーチンで読み出す。
+// そうでないルームは、ストアに保存された状態から返す。他のインスタンスに接続している参加者がいれば、それも含まれる。
+func (s *pokerServer) roomInfo(ctx context.Context, record *RoomRecord) (*pokerv1.RoomInfo, error) {
+	r, ok := s.rooms.get(record.ID)
+	if !ok {
+		return newRoomInfo(record.names(), record), nil
+	}
+	var info *pokerv1.RoomInfo
+	err := r.do(ctx, func(st *roomState) error {
+		record, err := st.store.GetRoom(ctx, st.id)
+		if err != nil {
+			return err
+		}
+		info = newRoomInfo(record.names(), record)
+		return nil
+	})
+	return info, err
+}
+
+// newRoomInfo namesを接続中の参加者として、ルームの状態を生成する
+func newRoomInfo(names []string, record *RoomRecord) *pokerv1.RoomInfo {
+	info := &pokerv1.RoomInfo{
+		RoomId:        record.ID,
+		Participants:  participantStatuses(names, record),
+		Deck:          record.Deck.toProto(),
+		Settings:      record.Settings.toProto(),
+		CreatedAt:     timestamppb.New(record.CreatedAt),
+		LastUsedAt:    timestamppb.New(record.LastUsedAt),
+		Locked:        record.Locked,
+		HasPassphrase: record.PassphraseHash != "",
+	}
+	if i := findStory(record.Stories, record.CurrentStory); i >= 0 {
+		info.CurrentStory = record.Stories[i].toProto()
+	}
+	return info
+}
+
+func (s *pokerServer) GetRoom(ctx context.Context, req *connect.Request[pokerv1.GetRoomRequest]) (*connect.Response[pokerv1.GetRoomResponse], error) {
+	loggerFrom(ctx).Debug("GetRoom function was invoked")
+
+	record, err := s.store.GetRoom(ctx, req.Msg.RoomId)
+	if err != nil {
+		return nil, roomNotFoundOr(req.Msg.RoomId, err)
+	}
+	info, err := s.roomInfo(ctx, record)
+	if err != nil {
+		return nil, roomNotFoundOr(req.Msg.RoomId, err)
+	}
+	if record.PassphraseHash != "" && !s.mayInspect(record, req) {
+		hideRoomDetails(info)
+	}
+
+	return connect.NewResponse(&pokerv1.GetRoomResponse{Room: info}), nil
+}
+
+// mayInspect 合言葉のあるルームの中身を、GetRoomの呼び出し元に見せてよいかを返す。
+// ルームに接続中の参加者としてセッショントークンを示した場合に真。
+// 合言葉では見せない。誰でも何度でも呼べるGetRoomで合言葉を確かめると、bcryptの計算でCPUを使わせたり、合言葉を総当たりで試したりできてしまうため。
+// セッションはストアに記録されているので、他のインスタンスに接続している参加者も確かめられる。
+func (s *pokerServer) mayInspect(record *RoomRecord, req *connect.Request[pokerv1.GetRoomRequest]) bool {
+	return record.authenticate(req.Msg.Id, req.Header().Get(sessionHeader))
+}
+
+// hideRoomDetails 合言葉を知らない人には見せない、参加者と現在のストーリーを取り除く
+func hideRoomDetails(info *pokerv1.RoomInfo) {
+	info.Participants = nil
+	info.CurrentStory = nil
+}
+
+func (s *pokerServer) ListRooms(ctx context.Context, req *connect.Request[pokerv1.ListRoomsRequest]) (*connect.Response[pokerv1.ListRoomsResponse], error) {
+	loggerFrom(ctx).Debug("ListRooms function was invoked")
+
+	pageSize := int(req.Msg.PageSize)
+	switch {
+	case pageSize < 0:
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("page_size must not be negative"),
+		)
+	case pageSize == 0:
+		pageSize = defaultPageSize
+	case pageSize > maxPageSize:
+		pageSize = maxPageSize
+	}
+	after, err := decodePageToken(req.Msg.PageToken)
+	if err != nil {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			err,
+		)
+	}
+
+	records, err := s.store.ListRooms(ctx)
+	if err != nil {
+		loggerFrom(ctx).Error("failed to list rooms", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	sort.Slice(records, func(i, j int) bool { return records[i].ID < records[j].ID })
+
+	query := strings.ToLower(req.Msg.Query)
+	res := &pokerv1.ListRoomsResponse{}
+	for _, record := range records {
+		if record.ID <= after || !record.Settings.Public || !strings.Contains(strings.ToLower(record.ID), query) {
+			continue
+		}
+		info, err := s.roomInfo(ctx, record)
+		if errors.Is(err, ErrRoomNotFound) {
+			// 一覧を作っている間に閉じられた
+			continue
+		}
+		if err != nil {
+			loggerFrom(ctx).Error("failed to get room", "room_id", record.ID, "error", err)
+			return nil, connect.NewError(connect.CodeInternal, err)
+		}
+		if req.Msg.ActiveOnly && len(info.Participants) == 0 {
+			continue
+		}
+		if record.PassphraseHash != "" {
+			hideRoomDetails(info)
+		}
+		// 次のページがあるかどうかを知るために、1件多く探す
+		if len(res.Rooms) == pageSize {
+			res.NextPageToken = encodePageToken(res.Rooms[pageSize-1].RoomId)
+			break
+		}
+		res.Rooms = append(res.Rooms, info)
+	}
+
+	return connect.NewResponse(res), nil
+}
+
+// encodePageToken 最後に返したルームのIDを、次のページのトークンにする
+func encodePageToken(roomId string) string {
+	return base64.RawURLEncoding.EncodeToString([]byte(roomId))
+}
+
+func decodePageToken(token string) (string, error) {
+	if token == "" {
+		return "", nil
+	}
+	b, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil || len(b) == 0 {
+		return "", ErrInvalidPageToken
+	}
+	return string(b), nil
+}
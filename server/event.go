@@ -0,0 +1,202 @@
+package main
+
+import (
+	"encoding/json"
+	"log/slog"
+	"math"
+	"sort"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+// ConnectResponseのeventに型付きのペイロードを詰めて返す関数群。
+// 旧クライアントのために、messageフィールドにも従来と同じ文字列を入れておく。
+
+func newRoomCreatedEvent(roomId, inviteCode string) *pokerv1.ConnectResponse {
+	return &pokerv1.ConnectResponse{
+		Id:      roomId,
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM,
+		Message: roomId,
+		Event: &pokerv1.ConnectResponse_RoomCreated{
+			RoomCreated: &pokerv1.RoomCreated{RoomId: roomId, InviteCode: formatInviteCode(inviteCode)},
+		},
+	}
+}
+
+func newJoinEvent(name string, role pokerv1.Role) *pokerv1.ConnectResponse {
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_JOIN,
+		Message: name,
+		Event: &pokerv1.ConnectResponse_ParticipantJoined{
+			ParticipantJoined: &pokerv1.ParticipantJoined{ParticipantId: name, Role: role},
+		},
+	}
+}
+
+func newLeaveEvent(name string, reason pokerv1.LeaveReason) *pokerv1.ConnectResponse {
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_LEAVE,
+		Message: name,
+		Event: &pokerv1.ConnectResponse_ParticipantLeft{
+			ParticipantLeft: &pokerv1.ParticipantLeft{ParticipantId: name, Reason: reason},
+		},
+	}
+}
+
+func newVoteEvent(name string) *pokerv1.ConnectResponse {
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_VOTE,
+		Message: name,
+		Event: &pokerv1.ConnectResponse_VoteCast{
+			VoteCast: &pokerv1.VoteCast{ParticipantId: name},
+		},
+	}
+}
+
+func newResetVoteEvent(name string) *pokerv1.ConnectResponse {
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_RESET_VOTE,
+		Message: name,
+		Event: &pokerv1.ConnectResponse_VoteReset{
+			VoteReset: &pokerv1.VoteReset{ParticipantId: name},
+		},
+	}
+}
+
+func newNewGameEvent(message string) *pokerv1.ConnectResponse {
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_NEW_GAME,
+		Message: message,
+		Event: &pokerv1.ConnectResponse_RoundStarted{
+			RoundStarted: &pokerv1.RoundStarted{Message: message},
+		},
+	}
+}
+
+func newRoleChangedEvent(name string, role pokerv1.Role) *pokerv1.ConnectResponse {
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_ROLE_CHANGED,
+		Message: name,
+		Event: &pokerv1.ConnectResponse_RoleChanged{
+			RoleChanged: &pokerv1.RoleChanged{ParticipantId: name, Role: role},
+		},
+	}
+}
+
+// newStoriesChangedEvent ストーリーの一覧と現在のストーリーを通知するイベントを生成する。
+// 旧クライアント向けのmessageには、現在のストーリーのタイトルを入れる。
+func newStoriesChangedEvent(stories []Story, current string) *pokerv1.ConnectResponse {
+	var title string
+	if i := findStory(stories, current); i >= 0 {
+		title = stories[i].Title
+	}
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_STORIES_CHANGED,
+		Message: title,
+		Event: &pokerv1.ConnectResponse_StoriesChanged{
+			StoriesChanged: &pokerv1.StoriesChanged{
+				Stories:        storiesToProto(stories),
+				CurrentStoryId: current,
+			},
+		},
+	}
+}
+
+// newStatusEvent ルームに参加した際に送る、接続中のユーザの投票状況とロール、デッキとストーリー、タイマーのスナップショットを生成する。
+// namesは接続中のユーザ名。timerは動いているタイマーで、なければnil。
+// 旧クライアント向けのmessageは投票者の投票状況なので、オブザーバーは含めない。
+func newStatusEvent(names []string, record *RoomRecord, timer *pokerv1.RoundTimer) *pokerv1.ConnectResponse {
+	participants := participantStatuses(names, record)
+	voted := make(map[string]bool, len(names))
+	for _, p := range participants {
+		if p.Role != pokerv1.Role_ROLE_OBSERVER {
+			voted[p.ParticipantId] = p.Voted
+		}
+	}
+
+	b, err := json.Marshal(voted)
+	if err != nil {
+		slog.Error("failed to marshal user vote status", "error", err)
+	}
+
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_STATUS,
+		Message: string(b),
+		Event: &pokerv1.ConnectResponse_RoomStatus{
+			RoomStatus: &pokerv1.RoomStatus{
+				Participants:   participants,
+				Deck:           record.Deck.toProto(),
+				Stories:        storiesToProto(record.Stories),
+				CurrentStoryId: record.CurrentStory,
+				Timer:          timer,
+				Settings:       record.Settings.toProto(),
+				InviteCode:     formatInviteCode(record.InviteCode),
+				Locked:         record.Locked,
+			},
+		},
+	}
+}
+
+// participantStatuses namesの参加者の投票状況とロールを、名前順に返す
+func participantStatuses(names []string, record *RoomRecord) []*pokerv1.ParticipantStatus {
+	participants := make([]*pokerv1.ParticipantStatus, 0, len(names))
+	for _, id := range names {
+		_, ok := record.Votes[id]
+		participants = append(participants, &pokerv1.ParticipantStatus{
+			ParticipantId: id,
+			Voted:         ok,
+			Role:          record.roleOf(id),
+		})
+	}
+	sort.Slice(participants, func(i, j int) bool {
+		return participants[i].ParticipantId < participants[j].ParticipantId
+	})
+	return participants
+}
+
+// voteEntries 投票したカードのラベルを、公開用のVoteEntryに変換する。
+// votesはユーザ名をキーとした投票したカードのラベル。
+func voteEntries(votes map[string]string, deck Deck) []*pokerv1.VoteEntry {
+	entries := make([]*pokerv1.VoteEntry, 0, len(votes))
+	for id, label := range votes {
+		entry := &pokerv1.VoteEntry{ParticipantId: id, Card: label}
+		if card, _, ok := deck.find(label); ok && card.Value != nil {
+			v := *card.Value
+			entry.Value = &v
+			entry.Vote = int32(math.Round(v))
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].ParticipantId < entries[j].ParticipantId
+	})
+	return entries
+}
+
+// newShowVotesEvent 投票結果を公開するイベントを生成する。
+// 数値を持たないカードも公開するが、平均などの統計は数値を持つカードだけから計算されている。
+func newShowVotesEvent(entries []*pokerv1.VoteEntry, stats *pokerv1.VoteStatistics) *pokerv1.ConnectResponse {
+	// 旧クライアント向けのJSON。ユーザ名と衝突しうるが、互換性のためAVERAGEキーに平均値を入れる
+	legacy := make(map[string]float32, len(entries)+1)
+	for _, e := range entries {
+		if e.Value != nil {
+			legacy[e.ParticipantId] = float32(*e.Value)
+		}
+	}
+	legacy[AVERAGE] = stats.Average
+	b, err := json.Marshal(legacy)
+	if err != nil {
+		slog.Error("failed to marshal votes", "error", err)
+	}
+
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_SHOW_VOTES,
+		Message: string(b),
+		Event: &pokerv1.ConnectResponse_VotesRevealed{
+			VotesRevealed: &pokerv1.VotesRevealed{
+				Votes:      entries,
+				Statistics: stats,
+			},
+		},
+	}
+}
@@ -0,0 +1,186 @@
+package main
+
+import (
+	"bytes"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"net/http"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+// exportPath ルームの履歴をファイルとして書き出すエンドポイント。
+// GET /export/{roomId}.{json|csv|md}?id={participant} で、参加者だけがダウンロードできる。
+// セッショントークンはヘッダでだけ受け付ける。URLに含めるとアクセスログや履歴、Refererから漏れるため。
+const exportPath = "/export/"
+
+// exportFormat 書き出す形式ごとのContent-Typeと描画関数
+type exportFormat struct {
+	contentType string
+	render      func(history *pokerv1.GetRoomHistoryResponse) ([]byte, error)
+}
+
+var exportFormats = map[string]exportFormat{
+	".json": {"application/json", renderJSON},
+	".csv":  {"text/csv; charset=utf-8", renderCSV},
+	".md":   {"text/markdown; charset=utf-8", renderMarkdown},
+}
+
+func (s *pokerServer) handleExport(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+	file := strings.TrimPrefix(r.URL.Path, exportPath)
+	ext := path.Ext(file)
+	format, ok := exportFormats[ext]
+	roomId := strings.TrimSuffix(file, ext)
+	if !ok || roomId == "" || strings.Contains(roomId, "/") {
+		http.NotFound(w, r)
+		return
+	}
+
+	participant := r.URL.Query().Get("id")
+	token := r.Header.Get(sessionHeader)
+
+	// セッションはストアに記録されているので、他のインスタンスに接続している参加者も書き出せる
+	record, err := s.store.GetRoom(r.Context(), roomId)
+	switch {
+	case errors.Is(err, ErrRoomNotFound) || err == nil && !record.authenticate(participant, token):
+		http.Error(w, ErrInvalidSession.Error(), http.StatusUnauthorized)
+		return
+	case err != nil:
+		// 存在しないルームも、参加していないルームと同様に扱う
+		slog.Warn("failed to export room", "room_id", roomId, "participant", participant, "error", err)
+		http.Error(w, ErrInvalidSession.Error(), http.StatusUnauthorized)
+		return
+	}
+
+	body, err := format.render(historyOf(record))
+	if err != nil {
+		slog.Error("failed to render history", "room_id", roomId, "error", err)
+		http.Error(w, "failed to export", http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", format.contentType)
+	w.Header().Set("Content-Disposition", fmt.Sprintf("attachment; filename=%q", path.Base(r.URL.Path)))
+	w.Write(body)
+}
+
+func renderJSON(history *pokerv1.GetRoomHistoryResponse) ([]byte, error) {
+	return protojson.MarshalOptions{Multiline: true}.Marshal(history)
+}
+
+// renderCSV 1ラウンドを1行として書き出す。各参加者の投票は、参加者ごとの列に入れる。
+func renderCSV(history *pokerv1.GetRoomHistoryResponse) ([]byte, error) {
+	voters := votersOf(history.Rounds)
+	header := []string{"round", "story_id", "story", "estimate", "started_at", "revealed_at", "ended_at", "average", "median", "consensus"}
+
+	var buf bytes.Buffer
+	w := csv.NewWriter(&buf)
+	w.Write(append(header, voters...))
+	for i, round := range history.Rounds {
+		stats := round.Statistics
+		row := []string{
+			strconv.Itoa(i + 1),
+			round.StoryId,
+			round.StoryTitle,
+			round.Estimate,
+			formatTime(round.StartedAt.AsTime()),
+			formatTime(round.RevealedAt.AsTime()),
+			"",
+			formatNumber(float64(stats.Average), stats.Count),
+			formatNumber(stats.Median, stats.Count),
+			strconv.FormatBool(stats.Consensus),
+		}
+		if round.EndedAt != nil {
+			row[6] = formatTime(round.EndedAt.AsTime())
+		}
+		cards := cardsOf(round)
+		for _, v := range voters {
+			row = append(row, cards[v])
+		}
+		w.Write(row)
+	}
+	w.Flush()
+	return buf.Bytes(), w.Error()
+}
+
+// renderMarkdown スプリントのノートに貼り付けられるように、ラウンドの一覧を表として書き出す
+func renderMarkdown(history *pokerv1.GetRoomHistoryResponse) ([]byte, error) {
+	var buf bytes.Buffer
+	buf.WriteString("| # | Story | Estimate | Votes | Average | Median | Consensus |\n")
+	buf.WriteString("|---|---|---|---|---|---|---|\n")
+	for i, round := range history.Rounds {
+		votes := make([]string, 0, len(round.Votes))
+		for _, v := range round.Votes {
+			votes = append(votes, v.ParticipantId+": "+v.Card)
+		}
+		consensus := ""
+		if round.Statistics.Consensus {
+			consensus = "✓"
+		}
+		fmt.Fprintf(&buf, "| %d | %s | %s | %s | %s | %s | %s |\n",
+			i+1,
+			escapeMarkdown(round.StoryTitle),
+			escapeMarkdown(round.Estimate),
+			escapeMarkdown(strings.Join(votes, ", ")),
+			formatNumber(float64(round.Statistics.Average), round.Statistics.Count),
+			formatNumber(round.Statistics.Median, round.Statistics.Count),
+			consensus,
+		)
+	}
+	return buf.Bytes(), nil
+}
+
+// votersOf 全てのラウンドで投票した参加者を名前順に返す
+func votersOf(rounds []*pokerv1.Round) []string {
+	seen := make(map[string]bool)
+	var voters []string
+	for _, round := range rounds {
+		for _, v := range round.Votes {
+			if !seen[v.ParticipantId] {
+				seen[v.ParticipantId] = true
+				voters = append(voters, v.ParticipantId)
+			}
+		}
+	}
+	sort.Strings(voters)
+	return voters
+}
+
+func cardsOf(round *pokerv1.Round) map[string]string {
+	cards := make(map[string]string, len(round.Votes))
+	for _, v := range round.Votes {
+		cards[v.ParticipantId] = v.Card
+	}
+	return cards
+}
+
+func formatTime(t time.Time) string {
+	return t.UTC().Format(time.RFC3339)
+}
+
+// formatNumber 統計値を小数第2位までに丸めて書き出す。数値のカードへの投票が一つもなければ空にする。
+func formatNumber(v float64, count int32) string {
+	if count == 0 {
+		return ""
+	}
+	return strconv.FormatFloat(math.Round(v*100)/100, 'f', -1, 64)
+}
+
+var markdownEscaper = strings.NewReplacer("|", `\|`, "\r\n", " ", "\n", " ", "\r", " ")
+
+func escapeMarkdown(s string) string {
+	return markdownEscaper.Replace(s)
+}
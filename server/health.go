@@ -0,0 +1,74 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+
+	"github.com/machimachida/grpc-planning-poker/gen/proto/v1/pokerv1connect"
+)
+
+// ロードバランサなどから、gRPCを使わずに状態を確かめるためのパス
+const (
+	// livenessPath プロセスが応答できれば常に200を返す
+	livenessPath = "/healthz"
+	// readinessPath リクエストを受け付けられる場合は200を、そうでなければ503を返す
+	readinessPath = "/readyz"
+)
+
+var ErrUnknownService = errors.New("unknown service")
+
+// ready リクエストを受け付けられるかを確かめ、受け付けられない場合はその理由を返す
+func (s *pokerServer) ready(ctx context.Context) error {
+	if s.rooms.isDraining() {
+		return ErrServerShutdown
+	}
+	if err := s.store.Ping(ctx); err != nil {
+		return fmt.Errorf("store is not ready: %w", err)
+	}
+	if err := s.rooms.bus.Ping(ctx); err != nil {
+		return fmt.Errorf("bus is not ready: %w", err)
+	}
+	return nil
+}
+
+// healthChecker gRPC標準のヘルスチェックプロトコルのCheckの実装。
+// サービス名が空の場合はサーバ全体の状態を、PlanningPokerServiceの場合はそのサービスの状態を返すが、
+// サービスは一つしかないのでどちらも同じ状態になる。
+// Watchはgrpchealthが実装していないので、Unimplementedを返す。停止を妨げる長いストリームも残らない。
+type healthChecker struct {
+	server *pokerServer
+}
+
+func (h *healthChecker) Check(ctx context.Context, req *grpchealth.CheckRequest) (*grpchealth.CheckResponse, error) {
+	if req.Service != "" && req.Service != pokerv1connect.PlanningPokerServiceName {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			fmt.Errorf("%w: %s", ErrUnknownService, req.Service),
+		)
+	}
+	if err := h.server.ready(ctx); err != nil {
+		return &grpchealth.CheckResponse{Status: grpchealth.StatusNotServing}, nil
+	}
+	return &grpchealth.CheckResponse{Status: grpchealth.StatusServing}, nil
+}
+
+// handleLiveness livenessPathのハンドラ
+func handleLiveness(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintln(w, "ok")
+}
+
+// handleReadiness readinessPathのハンドラ
+func (s *pokerServer) handleReadiness(w http.ResponseWriter, r *http.Request) {
+	if err := s.ready(r.Context()); err != nil {
+		http.Error(w, err.Error(), http.StatusServiceUnavailable)
+		return
+	}
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintln(w, "ok")
+}
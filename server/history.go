@@ -0,0 +1,151 @@
+package main
+
+import (
+	"context"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+// maxRounds ルームごとに保持するラウンドの履歴の最大件数。古いものから捨てる。
+const maxRounds = 500
+
+// Round 投票を公開したラウンドの記録。
+// 統計はVotesとルームのデッキから、読み出す際に計算する。
+// EndedAtは、NewGameで次のラウンドを始めるまではゼロ値。
+type Round struct {
+	StoryID    string            `json:"story_id,omitempty"`
+	StoryTitle string            `json:"story_title,omitempty"`
+	Votes      map[string]string `json:"votes"`
+	Estimate   string            `json:"estimate,omitempty"`
+	StartedAt  time.Time         `json:"started_at"`
+	RevealedAt time.Time         `json:"revealed_at"`
+	EndedAt    time.Time         `json:"ended_at"`
+}
+
+func cloneRounds(rounds []Round) []Round {
+	if rounds == nil {
+		return nil
+	}
+	c := make([]Round, len(rounds))
+	for i, r := range rounds {
+		c[i] = r
+		c[i].Votes = make(map[string]string, len(r.Votes))
+		for k, v := range r.Votes {
+			c[i].Votes[k] = v
+		}
+	}
+	return c
+}
+
+func (r Round) toProto(deck Deck) *pokerv1.Round {
+	res := &pokerv1.Round{
+		StoryId:    r.StoryID,
+		StoryTitle: r.StoryTitle,
+		Votes:      voteEntries(r.Votes, deck),
+		Statistics: computeStatistics(r.Votes, deck),
+		Estimate:   r.Estimate,
+		StartedAt:  timestamppb.New(r.StartedAt),
+		RevealedAt: timestamppb.New(r.RevealedAt),
+	}
+	if !r.EndedAt.IsZero() {
+		res.EndedAt = timestamppb.New(r.EndedAt)
+	}
+	return res
+}
+
+// historyOf ルームの履歴をGetRoomHistoryのレスポンスに変換する
+func historyOf(record *RoomRecord) *pokerv1.GetRoomHistoryResponse {
+	rounds := make([]*pokerv1.Round, 0, len(record.Rounds))
+	for _, r := range record.Rounds {
+		rounds = append(rounds, r.toProto(record.Deck))
+	}
+	return &pokerv1.GetRoomHistoryResponse{
+		Rounds:  rounds,
+		Stories: storiesToProto(record.Stories),
+	}
+}
+
+// isCurrentRound roundsの最後が、現在のラウンドの記録かどうかを返す
+func isCurrentRound(record *RoomRecord) bool {
+	n := len(record.Rounds)
+	return n > 0 && record.Rounds[n-1].StartedAt.Equal(record.RoundStartedAt)
+}
+
+// recordRound 現在のラウンドの投票を公開した時点の状態を履歴に残す。
+// 同じラウンドで何度も公開した場合は、最後に公開した状態で上書きする。
+func (st *roomState) recordRound(ctx context.Context, record *RoomRecord, revealedAt time.Time) {
+	round := Round{
+		StoryID:    record.CurrentStory,
+		Votes:      record.Votes,
+		StartedAt:  record.RoundStartedAt,
+		RevealedAt: revealedAt,
+	}
+	if i := findStory(record.Stories, record.CurrentStory); i >= 0 {
+		round.StoryTitle = record.Stories[i].Title
+	}
+
+	rounds := record.Rounds
+	if isCurrentRound(record) {
+		round.Estimate = rounds[len(rounds)-1].Estimate
+		rounds[len(rounds)-1] = round
+	} else {
+		rounds = append(rounds, round)
+	}
+	if len(rounds) > maxRounds {
+		rounds = rounds[len(rounds)-maxRounds:]
+	}
+	if err := st.store.SaveRounds(ctx, st.id, rounds); err != nil {
+		st.logger(ctx).Error("failed to save rounds", "error", err)
+	}
+}
+
+// endRound 現在のラウンドを終了した時刻を記録し、新しいラウンドを始める
+func (st *roomState) endRound(ctx context.Context, record *RoomRecord, endedAt time.Time) error {
+	if isCurrentRound(record) {
+		record.Rounds[len(record.Rounds)-1].EndedAt = endedAt
+		if err := st.store.SaveRounds(ctx, st.id, record.Rounds); err != nil {
+			return roomNotFoundOr(st.id, err)
+		}
+	}
+	if err := st.store.StartRound(ctx, st.id, endedAt); err != nil {
+		return roomNotFoundOr(st.id, err)
+	}
+	return nil
+}
+
+// recordEstimate storyIdのストーリーを最後に見積もったラウンドに、受け入れた見積もりを記録する
+func (st *roomState) recordEstimate(ctx context.Context, record *RoomRecord, storyId, estimate string) {
+	for i := len(record.Rounds) - 1; i >= 0; i-- {
+		if record.Rounds[i].StoryID != storyId {
+			continue
+		}
+		record.Rounds[i].Estimate = estimate
+		if err := st.store.SaveRounds(ctx, st.id, record.Rounds); err != nil {
+			st.logger(ctx).Error("failed to save rounds", "error", err)
+		}
+		return
+	}
+}
+
+func (s *pokerServer) GetRoomHistory(ctx context.Context, req *connect.Request[pokerv1.GetRoomHistoryRequest]) (*connect.Response[pokerv1.GetRoomHistoryResponse], error) {
+	loggerFrom(ctx).Debug("GetRoomHistory function was invoked")
+
+	var res *pokerv1.GetRoomHistoryResponse
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		record, err := st.requireParticipant(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+		res = historyOf(record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(res), nil
+}
@@ -0,0 +1,79 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"math/big"
+	"strings"
+
+	"connectrpc.com/connect"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+// inviteAlphabet 招待コードに使う文字。読み間違えやすいI, L, O, Uを除いたCrockfordのBase32。
+const inviteAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// inviteCodeLength 招待コードの文字数。表示する際は4文字ごとにハイフンで区切る。
+const inviteCodeLength = 8
+
+var ErrExistInviteCode = errors.New("this invite code is already used")
+
+// newInviteCode ランダムな招待コードを生成する
+func newInviteCode() (string, error) {
+	b := make([]byte, inviteCodeLength)
+	max := big.NewInt(int64(len(inviteAlphabet)))
+	for i := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[i] = inviteAlphabet[n.Int64()]
+	}
+	return string(b), nil
+}
+
+// normalizeInviteCode 入力された招待コードから大文字小文字、ハイフンと空白の違いを取り除く。
+// 読み間違えやすいO, I, Lは、それぞれ0と1として扱う。
+func normalizeInviteCode(code string) string {
+	var b strings.Builder
+	for _, c := range strings.ToUpper(code) {
+		switch c {
+		case '-', ' ':
+			continue
+		case 'O':
+			c = '0'
+		case 'I', 'L':
+			c = '1'
+		}
+		b.WriteRune(c)
+	}
+	return b.String()
+}
+
+// formatInviteCode 招待コードを、共有しやすいように4文字ごとにハイフンで区切る
+func formatInviteCode(code string) string {
+	if len(code) != inviteCodeLength {
+		return code
+	}
+	return code[:4] + "-" + code[4:]
+}
+
+func (s *pokerServer) ResolveInviteCode(ctx context.Context, req *connect.Request[pokerv1.ResolveInviteCodeRequest]) (*connect.Response[pokerv1.ResolveInviteCodeResponse], error) {
+	loggerFrom(ctx).Debug("ResolveInviteCode function was invoked")
+
+	record, err := s.store.FindRoomByInviteCode(ctx, normalizeInviteCode(req.Msg.InviteCode))
+	if errors.Is(err, ErrRoomNotFound) {
+		return nil, connect.NewError(
+			connect.CodeNotFound,
+			errors.New("invite code not found"),
+		)
+	}
+	if err != nil {
+		loggerFrom(ctx).Error("failed to find room", "error", err)
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(&pokerv1.ResolveInviteCodeResponse{RoomId: record.ID}), nil
+}
@@ -0,0 +1,123 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+// ストリームを終了させた理由。これらの理由で終了させたストリームには、キューに残ったイベントを送り切ってから終了する。
+var (
+	ErrLeft       = errors.New("left the room")
+	ErrKicked     = errors.New("kicked by the facilitator")
+	ErrRoomClosed = errors.New("the room was closed")
+)
+
+// isGracefulStop causeがルームから正常に外されたことによる終了かどうかを返す
+func isGracefulStop(cause error) bool {
+	return errors.Is(cause, ErrLeft) || errors.Is(cause, ErrKicked) || errors.Is(cause, ErrRoomClosed) || errors.Is(cause, ErrServerShutdown)
+}
+
+func (s *pokerServer) LeaveRoom(ctx context.Context, req *connect.Request[pokerv1.LeaveRoomRequest]) (*connect.Response[pokerv1.LeaveRoomResponse], error) {
+	loggerFrom(ctx).Debug("LeaveRoom function was invoked")
+
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		if _, err := st.requireParticipant(ctx, req.Msg.Id); err != nil {
+			return err
+		}
+		st.remove(ctx, req.Msg.Id, pokerv1.LeaveReason_LEAVE_REASON_LEFT, ErrLeft)
+		if !st.closed {
+			st.touch(ctx)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pokerv1.LeaveRoomResponse{
+		Message: "accepted",
+	}), nil
+}
+
+func (s *pokerServer) KickParticipant(ctx context.Context, req *connect.Request[pokerv1.KickParticipantRequest]) (*connect.Response[pokerv1.KickParticipantResponse], error) {
+	loggerFrom(ctx).Debug("KickParticipant function was invoked", "target", req.Msg.ParticipantId)
+
+	if req.Msg.ParticipantId == req.Msg.Id {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			errors.New("use LeaveRoom to leave the room"),
+		)
+	}
+
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		record, err := st.authorizeFacilitator(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+		return st.kick(ctx, record, req.Msg.ParticipantId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pokerv1.KickParticipantResponse{
+		Message: "accepted",
+	}), nil
+}
+
+// kick participantの投票を消してルームから外す。
+// 接続していなくても、参加者として残っているか投票が残っている場合は、それらを消して退出したことを通知する。
+func (st *roomState) kick(ctx context.Context, record *RoomRecord, participant string) error {
+	_, voted := record.Votes[participant]
+	if !voted && !record.hasParticipant(participant) {
+		err := fmt.Errorf("participant %s not found", participant)
+		return connect.NewError(
+			connect.CodeNotFound,
+			err,
+		)
+	}
+
+	if voted {
+		if err := st.store.ClearVote(ctx, st.id, participant); err != nil {
+			return roomNotFoundOr(st.id, err)
+		}
+	}
+	st.logger(ctx).Info("participant is kicked", "target", participant)
+	st.remove(ctx, participant, pokerv1.LeaveReason_LEAVE_REASON_KICKED, ErrKicked)
+	st.touch(ctx)
+	return nil
+}
+
+func (s *pokerServer) CloseRoom(ctx context.Context, req *connect.Request[pokerv1.CloseRoomRequest]) (*connect.Response[pokerv1.CloseRoomResponse], error) {
+	loggerFrom(ctx).Debug("CloseRoom function was invoked")
+
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		if _, err := st.authorizeFacilitator(ctx, req.Msg.Id); err != nil {
+			return err
+		}
+		st.close(ctx, req.Msg.Id)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pokerv1.CloseRoomResponse{
+		Message: "accepted",
+	}), nil
+}
+
+func newRoomClosedEvent(closedBy string) *pokerv1.ConnectResponse {
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_ROOM_CLOSED,
+		Message: closedBy,
+		Event: &pokerv1.ConnectResponse_RoomClosed{
+			RoomClosed: &pokerv1.RoomClosed{ClosedBy: closedBy},
+		},
+	}
+}
@@ -0,0 +1,170 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+)
+
+// requestIDHeader リクエストIDをやり取りするヘッダ。
+// クライアントが指定した場合はそれを使い、指定しない場合はサーバが生成してレスポンスヘッダで返す。
+const requestIDHeader = "X-Request-Id"
+
+// maxRequestIDLength クライアントが指定したリクエストIDとして受け付ける最大の長さ
+const maxRequestIDLength = 128
+
+// newLogger levelとformatに従って、wに書き込むロガーを作る。
+// levelはdebug, info, warn, errorのいずれか、formatはjsonかtext。
+func newLogger(w io.Writer, level, format string) (*slog.Logger, error) {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return nil, fmt.Errorf("invalid log level %q", level)
+	}
+	opts := &slog.HandlerOptions{Level: l}
+	switch format {
+	case "json":
+		return slog.New(slog.NewJSONHandler(w, opts)), nil
+	case "text":
+		return slog.New(slog.NewTextHandler(w, opts)), nil
+	default:
+		return nil, fmt.Errorf("invalid log format %q", format)
+	}
+}
+
+type loggerKey struct{}
+
+// requestLogger リクエストの処理中に使うロガー。
+// ストリームでは最初のメッセージを受信してから属性を足すので、ポインタでcontextに入れておく。
+type requestLogger struct {
+	logger *slog.Logger
+}
+
+// loggerFrom ctxのリクエストのロガーを返す。リクエストの外ではデフォルトのロガーを返す。
+func loggerFrom(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*requestLogger); ok {
+		return l.logger
+	}
+	return slog.Default()
+}
+
+// withLogAttrs ctxのロガーに属性を足したcontextを返す
+func withLogAttrs(ctx context.Context, args ...any) context.Context {
+	return context.WithValue(ctx, loggerKey{}, &requestLogger{logger: loggerFrom(ctx).With(args...)})
+}
+
+// logger ルームの処理で使うロガーを返す。
+// リクエストの処理中はそのロガーを、タイマーなどリクエストの外ではルームのIDを付けたロガーを返す。
+func (st *roomState) logger(ctx context.Context) *slog.Logger {
+	if l, ok := ctx.Value(loggerKey{}).(*requestLogger); ok {
+		return l.logger
+	}
+	return slog.Default().With(slog.String("room_id", st.id))
+}
+
+// requestAttrs リクエストのメッセージから、ログに付けるルームのIDと参加者を取り出す
+func requestAttrs(msg any) []any {
+	var attrs []any
+	if r, ok := msg.(interface{ GetRoomId() string }); ok && r.GetRoomId() != "" {
+		attrs = append(attrs, slog.String("room_id", r.GetRoomId()))
+	}
+	if r, ok := msg.(interface{ GetId() string }); ok && r.GetId() != "" {
+		attrs = append(attrs, slog.String("participant", r.GetId()))
+	}
+	return attrs
+}
+
+// loggingInterceptor RPCごとにリクエストID、プロシージャ、ルームのID、参加者を付けたロガーをcontextに入れ、
+// RPCが終わった時に結果をログに出す。
+type loggingInterceptor struct {
+	logger *slog.Logger
+}
+
+func newLoggingInterceptor(logger *slog.Logger) *loggingInterceptor {
+	return &loggingInterceptor{logger: logger}
+}
+
+// begin リクエストのロガーを作り、それを入れたcontextとリクエストIDを返す
+func (i *loggingInterceptor) begin(ctx context.Context, spec connect.Spec, requestID string) (context.Context, *requestLogger, string) {
+	if requestID == "" || len(requestID) > maxRequestIDLength {
+		requestID = uuid.NewString()
+	}
+	l := &requestLogger{logger: i.logger.With(
+		slog.String("request_id", requestID),
+		slog.String("procedure", spec.Procedure),
+	)}
+	return context.WithValue(ctx, loggerKey{}, l), l, requestID
+}
+
+// end RPCの結果をログに出す。サーバ側の問題はError、クライアントの誤りはWarnにする。
+func (l *requestLogger) end(msg string, start time.Time, err error) {
+	duration := slog.Duration("duration", time.Since(start))
+	if err == nil {
+		l.logger.Info(msg, duration)
+		return
+	}
+	code := connect.CodeOf(err)
+	switch code {
+	case connect.CodeInternal, connect.CodeUnknown, connect.CodeDataLoss, connect.CodeUnavailable:
+		l.logger.Error(msg, duration, slog.String("code", code.String()), slog.Any("error", err))
+	default:
+		l.logger.Warn(msg, duration, slog.String("code", code.String()), slog.Any("error", err))
+	}
+}
+
+func (i *loggingInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		ctx, l, requestID := i.begin(ctx, req.Spec(), req.Header().Get(requestIDHeader))
+		l.logger = l.logger.With(requestAttrs(req.Any())...)
+
+		start := time.Now()
+		res, err := next(ctx, req)
+		l.end("rpc finished", start, err)
+
+		var connectErr *connect.Error
+		if errors.As(err, &connectErr) {
+			connectErr.Meta().Set(requestIDHeader, requestID)
+		} else if res != nil {
+			res.Header().Set(requestIDHeader, requestID)
+		}
+		return res, err
+	}
+}
+
+func (i *loggingInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *loggingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		ctx, l, requestID := i.begin(ctx, conn.Spec(), conn.RequestHeader().Get(requestIDHeader))
+		conn.ResponseHeader().Set(requestIDHeader, requestID)
+
+		start := time.Now()
+		err := next(ctx, &loggingConn{StreamingHandlerConn: conn, logger: l})
+		l.end("stream finished", start, err)
+		return err
+	}
+}
+
+// loggingConn 受信したメッセージからルームのIDと参加者をロガーに足す
+type loggingConn struct {
+	connect.StreamingHandlerConn
+	logger *requestLogger
+}
+
+func (c *loggingConn) Receive(msg any) error {
+	err := c.StreamingHandlerConn.Receive(msg)
+	if err == nil {
+		c.logger.logger = c.logger.logger.With(requestAttrs(msg)...)
+	}
+	return err
+}
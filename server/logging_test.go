@@ -0,0 +1,117 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	"sync"
+	"testing"
+
+	"connectrpc.com/connect"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+	"github.com/machimachida/grpc-planning-poker/gen/proto/v1/pokerv1connect"
+)
+
+// syncBuffer 複数のゴルーチンから書き込まれるログを集める
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+// records 書き込まれたJSONのログを1行ずつ読む
+func (b *syncBuffer) records(t *testing.T) []map[string]any {
+	t.Helper()
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	var records []map[string]any
+	for _, line := range bytes.Split(bytes.TrimSpace(b.buf.Bytes()), []byte("\n")) {
+		var record map[string]any
+		if err := json.Unmarshal(line, &record); err != nil {
+			t.Fatalf("log is not JSON: %s", line)
+		}
+		records = append(records, record)
+	}
+	return records
+}
+
+func TestNewLogger(t *testing.T) {
+	if _, err := newLogger(&bytes.Buffer{}, "verbose", "json"); err == nil {
+		t.Fatal("expected an error for an unknown level")
+	}
+	if _, err := newLogger(&bytes.Buffer{}, "info", "xml"); err == nil {
+		t.Fatal("expected an error for an unknown format")
+	}
+
+	var buf bytes.Buffer
+	logger, err := newLogger(&buf, "warn", "text")
+	if err != nil {
+		t.Fatal(err)
+	}
+	logger.Info("hidden")
+	logger.Warn("shown")
+	if bytes.Contains(buf.Bytes(), []byte("hidden")) || !bytes.Contains(buf.Bytes(), []byte("msg=shown")) {
+		t.Fatalf("unexpected logs %q", buf.String())
+	}
+}
+
+func TestLoggingInterceptor(t *testing.T) {
+	buf := &syncBuffer{}
+	logger, err := newLogger(buf, "debug", "json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defaultLogger := slog.Default()
+	slog.SetDefault(logger)
+	t.Cleanup(func() { slog.SetDefault(defaultLogger) })
+
+	ts := newTestServer(t)
+	client := pokerv1connect.NewPlanningPokerServiceClient(ts.Client(), ts.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "logging"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	receive(stream).expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	streamRequestID := stream.ResponseHeader().Get(requestIDHeader)
+	if streamRequestID == "" {
+		t.Fatal("request id was not issued")
+	}
+
+	// クライアントが指定したリクエストIDは、エラーのレスポンスでもそのまま返す
+	req := withSession(&pokerv1.VoteRequest{Id: "Taro", RoomId: "logging", Card: "3"}, "wrong")
+	req.Header().Set(requestIDHeader, "vote-1")
+	_, err = client.Vote(ctx, req)
+	var connectErr *connect.Error
+	if !errors.As(err, &connectErr) || connectErr.Meta().Get(requestIDHeader) != "vote-1" {
+		t.Fatalf("expected the request id in the error, got %v", err)
+	}
+
+	var created, finished map[string]any
+	for _, record := range buf.records(t) {
+		switch record["msg"] {
+		case "room created":
+			created = record
+		case "rpc finished":
+			finished = record
+		}
+	}
+	if created == nil || created["request_id"] != streamRequestID || created["room_id"] != "logging" || created["participant"] != "Taro" ||
+		created["procedure"] != pokerv1connect.PlanningPokerServiceCreateRoomProcedure {
+		t.Fatalf("unexpected record %v", created)
+	}
+	if finished == nil || finished["request_id"] != "vote-1" || finished["room_id"] != "logging" || finished["participant"] != "Taro" ||
+		finished["procedure"] != pokerv1connect.PlanningPokerServiceVoteProcedure || finished["level"] != "WARN" || finished["code"] != "unauthenticated" {
+		t.Fatalf("unexpected record %v", finished)
+	}
+}
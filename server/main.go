@@ -2,15 +2,22 @@ package main
 
 import (
 	"context"
-	"encoding/json"
 	"errors"
+	"flag"
 	"fmt"
-	"log"
+	"log/slog"
+	"net"
 	"net/http"
+	"os"
+	"os/signal"
 	"sync"
+	"syscall"
 	"time"
 
 	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+	"connectrpc.com/grpcreflect"
+	"github.com/google/uuid"
 	"github.com/rs/cors"
 
 	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
@@ -21,19 +28,43 @@ const (
 	AVERAGE = "average"
 )
 
-var (
-	rm = RoomMap{rooms: make(map[string]*Room)}
-)
-
 var (
 	ErrReservedUserName = errors.New("this name is reserved")
 	ErrExistRoom        = errors.New("this room is already exist")
+	ErrTooManyRooms     = errors.New("the number of rooms reached the limit")
 )
 
-type pokerServer struct{}
+// pokerServer サービスの実装。
+// createMuは、ルームの数の上限を確かめてから作成するまでの間に、他のルームが作成されないようにする。
+type pokerServer struct {
+	store    RoomStore
+	rooms    *RoomMap
+	metrics  *serverMetrics
+	maxRooms int
+	createMu sync.Mutex
+}
+
+func newPokerServer(store RoomStore, bus Bus, cfg Config) *pokerServer {
+	metrics := newServerMetrics()
+	rooms := NewRoomMap(store, bus, cfg.QueueSize, cfg.MaxParticipants, metrics)
+	metrics.watchRooms(rooms)
+	return &pokerServer{
+		store:    store,
+		rooms:    rooms,
+		metrics:  metrics,
+		maxRooms: cfg.MaxRooms,
+	}
+}
 
 func (s *pokerServer) CreateRoom(ctx context.Context, req *connect.Request[pokerv1.CreateRoomRequest], stream *connect.ServerStream[pokerv1.ConnectResponse]) error {
-	log.Println("CreateRoom function was invoked with a request from " + req.Msg.Id)
+	loggerFrom(ctx).Debug("CreateRoom function was invoked")
+
+	if s.rooms.isDraining() {
+		return connect.NewError(
+			connect.CodeUnavailable,
+			ErrServerShutdown,
+		)
+	}
 
 	if req.Msg.Id == AVERAGE {
 		return connect.NewError(
@@ -42,37 +73,84 @@ func (s *pokerServer) CreateRoom(ctx context.Context, req *connect.Request[poker
 		)
 	}
 
-	rm.mu.Lock()
-	for room := range rm.rooms {
-		if room == req.Msg.Id {
-			rm.mu.Unlock()
-			return connect.NewError(
-				connect.CodeAlreadyExists,
-				ErrExistRoom,
-			)
-		}
+	deck, err := deckFromProto(req.Msg.Deck)
+	if err != nil {
+		loggerFrom(ctx).Info("invalid deck", "error", err)
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			err,
+		)
+	}
+
+	settings, err := settingsFromProto(req.Msg.Settings)
+	if err != nil {
+		loggerFrom(ctx).Info("invalid settings", "error", err)
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			err,
+		)
+	}
+
+	passphraseHash, err := hashPassphrase(req.Msg.Passphrase)
+	if errors.Is(err, ErrPassphraseTooLong) {
+		return connect.NewError(
+			connect.CodeInvalidArgument,
+			err,
+		)
+	}
+	if err != nil {
+		loggerFrom(ctx).Error("failed to hash passphrase", "error", err)
+		return connect.NewError(connect.CodeInternal, err)
 	}
 
-	id := req.Msg.RoomId
-	rm.rooms[id] = &Room{
-		id:          id,
-		connections: ConnectionMap{streams: make(map[string]StreamState, 1)},
-		voteMap:     &sync.Map{},
+	// ルームを作成したユーザがファシリテータになる
+	record := &RoomRecord{
+		ID:             req.Msg.RoomId,
+		PassphraseHash: passphraseHash,
+		Deck:           deck,
+		Settings:       settings,
+		Votes:          make(map[string]string),
+		Roles:          map[string]pokerv1.Role{req.Msg.Id: pokerv1.Role_ROLE_FACILITATOR},
+	}
+	err = s.createRoom(ctx, record)
+	if errors.Is(err, ErrExistRoom) {
+		return connect.NewError(
+			connect.CodeAlreadyExists,
+			ErrExistRoom,
+		)
+	}
+	if errors.Is(err, ErrTooManyRooms) {
+		return connect.NewError(
+			connect.CodeResourceExhausted,
+			err,
+		)
+	}
+	if err != nil {
+		loggerFrom(ctx).Error("failed to create room", "error", err)
+		return connect.NewError(connect.CodeInternal, err)
 	}
-	rm.mu.Unlock()
 
-	log.Println("room created: " + id)
+	id := record.ID
+	// IDをサーバが生成した場合は、リクエストにルームのIDが含まれていない
+	if req.Msg.RoomId == "" {
+		ctx = withLogAttrs(ctx, slog.String("room_id", id))
+	}
+	loggerFrom(ctx).Info("room created")
 
-	err := stream.Send(&pokerv1.ConnectResponse{
-		Id:      id,
-		Type:    pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM,
-		Message: id,
-	})
+	token := issueSession(stream)
+	err = stream.Send(newRoomCreatedEvent(id, record.InviteCode))
 	if err != nil {
 		return connect.NewError(connect.CodeInternal, err)
 	}
 
-	err = connectWithRoom(ctx, stream, id, req.Msg.Id)
+	err = s.connectWithRoom(ctx, stream, id, joinRequest{
+		name:     req.Msg.Id,
+		token:    token,
+		admitted: true,
+	})
+	if connect.CodeOf(err) != connect.CodeUnknown {
+		return err
+	}
 	if err != nil {
 		return connect.NewError(
 			connect.CodeInternal,
@@ -82,8 +160,49 @@ func (s *pokerServer) CreateRoom(ctx context.Context, req *connect.Request[poker
 	return nil
 }
 
+// maxCreateRoomAttempts 生成したIDや招待コードが既存のルームと衝突した際に、作り直す回数
+const maxCreateRoomAttempts = 5
+
+// createRoom recordのルームを、新しい招待コードを発行してストアに作成する。
+// IDが指定されていなければ、推測できないIDを生成する。
+// 生成したIDや招待コードが衝突した場合は作り直し、指定されたIDが衝突した場合はErrExistRoomを返す。
+// ルームの数が上限に達している場合はErrTooManyRoomsを返す。
+func (s *pokerServer) createRoom(ctx context.Context, record *RoomRecord) error {
+	if s.maxRooms > 0 {
+		s.createMu.Lock()
+		defer s.createMu.Unlock()
+
+		rooms, err := s.store.ListRooms(ctx)
+		if err != nil {
+			return err
+		}
+		if len(rooms) >= s.maxRooms {
+			return ErrTooManyRooms
+		}
+	}
+
+	generateId := record.ID == ""
+	var err error
+	for i := 0; i < maxCreateRoomAttempts; i++ {
+		if generateId {
+			record.ID = uuid.NewString()
+		}
+		record.InviteCode, err = newInviteCode()
+		if err != nil {
+			return err
+		}
+		err = s.store.CreateRoom(ctx, record)
+		if errors.Is(err, ErrExistInviteCode) || (generateId && errors.Is(err, ErrExistRoom)) {
+			loggerFrom(ctx).Info("retry creating room", "error", err)
+			continue
+		}
+		return err
+	}
+	return err
+}
+
 func (s *pokerServer) Connect(ctx context.Context, req *connect.Request[pokerv1.ConnectRequest], stream *connect.ServerStream[pokerv1.ConnectResponse]) error {
-	log.Println("Connect function was invoked with a request from " + req.Msg.Id)
+	loggerFrom(ctx).Debug("Connect function was invoked", "resume_after", req.Msg.ResumeAfter)
 
 	if req.Msg.Id == AVERAGE {
 		return connect.NewError(
@@ -92,222 +211,421 @@ func (s *pokerServer) Connect(ctx context.Context, req *connect.Request[pokerv1.
 		)
 	}
 
-	rm.mu.Lock()
-	for room := range rm.rooms {
-		if room == req.Msg.RoomId {
-			rm.mu.Unlock()
-			err := connectWithRoom(ctx, stream, room, req.Msg.Id)
-			if err != nil {
-				return connect.NewError(
-					connect.CodeInternal,
-					err,
-				)
-			}
-			return nil
-		}
+	record, err := s.store.GetRoom(ctx, req.Msg.RoomId)
+	if errors.Is(err, ErrRoomNotFound) {
+		return connect.NewError(
+			connect.CodeNotFound,
+			errors.New("room not found"),
+		)
+	}
+	if err != nil {
+		loggerFrom(ctx).Error("failed to get room", "error", err)
+		return connect.NewError(connect.CodeInternal, err)
 	}
-	rm.mu.Unlock()
-
-	return connect.NewError(
-		connect.CodeNotFound,
-		errors.New("room not found"),
-	)
-}
 
-func connectWithRoom(ctx context.Context, stream *connect.ServerStream[pokerv1.ConnectResponse], roomId, name string) error {
-	r, ok := rm.rooms[roomId]
-	if !ok {
-		msg := fmt.Sprintf("room %s not found", roomId)
-		err := errors.New(msg)
-		log.Println(err)
+	// 再接続の場合は、以前に発行したトークンをヘッダで提示してもらう
+	token := issueSession(stream)
+	err = s.connectWithRoom(ctx, stream, req.Msg.RoomId, joinRequest{
+		name:        req.Msg.Id,
+		token:       token,
+		presented:   req.Header().Get(sessionHeader),
+		resumeAfter: req.Msg.ResumeAfter,
+		observer:    req.Msg.Observer,
+		// 合言葉の検証は時間がかかるので、ルームのゴルーチンに入る前に済ませておく
+		admitted: record.checkPassphrase(req.Msg.Passphrase),
+	})
+	if connect.CodeOf(err) != connect.CodeUnknown {
 		return err
 	}
-
-	err := r.connections.Connect(ctx, stream, name)
 	if err != nil {
-		log.Println("failed to connect", err)
-		return err
+		return connect.NewError(
+			connect.CodeInternal,
+			err,
+		)
 	}
+	return nil
+}
 
-	// クライアントがルームに参加した際の、他ユーザの接続状況を通知する
-	userVoteStatus := make(map[string]bool, len(r.connections.streams))
-	for id := range r.connections.streams {
-		if _, ok := r.voteMap.Load(id); ok {
-			userVoteStatus[id] = true
-		} else {
-			userVoteStatus[id] = false
-		}
+// connectWithRoom join.nameのクライアントをルームに参加させ、ストリームが切断されるまでブロックする。
+// resumeAfterが指定された場合は、見逃したイベントを再送して同じnameの古いストリームと置き換える。
+func (s *pokerServer) connectWithRoom(ctx context.Context, stream *connect.ServerStream[pokerv1.ConnectResponse], roomId string, join joinRequest) error {
+	// 再接続により置き換えられた場合や、イベントの受信が遅れた場合に、ストリームを終了させるためのcancel
+	ctx, cancel := context.WithCancelCause(ctx)
+	defer cancel(nil)
+
+	// サーバの再起動直後など、ストアにだけルームが残っている場合はここで接続の管理を始める
+	var r *Room
+	queue := make(chan *pokerv1.ConnectResponse, s.rooms.queueSize)
+	err := s.rooms.do(ctx, roomId, func(st *roomState) error {
+		r = st.room
+		_, err := st.connect(ctx, cancel, stream, queue, join)
+		return err
+	})
+	if errors.Is(err, ErrAlreadyConnected) {
+		return connect.NewError(connect.CodeAlreadyExists, err)
+	}
+	if errors.Is(err, ErrInvalidSession) {
+		loggerFrom(ctx).Warn("failed to resume", "error", err)
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if errors.Is(err, ErrWrongPassphrase) {
+		return connect.NewError(connect.CodeUnauthenticated, err)
+	}
+	if errors.Is(err, ErrRoomLocked) {
+		return connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if errors.Is(err, ErrRoomFull) {
+		return connect.NewError(connect.CodeResourceExhausted, err)
+	}
+	if errors.Is(err, ErrServerShutdown) {
+		return connect.NewError(connect.CodeUnavailable, err)
 	}
-	b, err := json.Marshal(userVoteStatus)
 	if err != nil {
-		log.Println("failed to marshal user vote status.", err)
-	} else {
-		err := stream.Send(&pokerv1.ConnectResponse{
-			Message: string(b),
-			Type:    pokerv1.MessageType_MESSAGE_TYPE_STATUS,
-		})
-		if err != nil {
-			log.Println("failed to send message.", err)
-		}
+		return roomNotFoundOr(roomId, err)
 	}
+	s.metrics.connectedParticipants.Inc()
+	defer s.metrics.connectedParticipants.Dec()
 
-	// 参加したことを全ユーザに通知する
-	r.connections.Broadcast(name, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
-	r.currentUsedAt = time.Now()
-
-	for {
+	// ルームのゴルーチンがキューに入れたイベントを、切断されるまで順に送る
+	for done := false; !done; {
 		select {
-		case <-ctx.Done():
-			log.Println(name + " is disconnected from " + roomId)
-			if err := ctx.Err(); err != nil {
-				log.Println(name, err)
+		case res := <-queue:
+			if err := stream.Send(res); err != nil {
+				s.metrics.broadcastFailures.WithLabelValues(failureSendError).Inc()
+				loggerFrom(ctx).Info("failed to send message", "error", err)
+				cancel(err)
 			}
-			r.connections.Disconnect(name)
-			r.connections.Broadcast(name, pokerv1.MessageType_MESSAGE_TYPE_LEAVE)
-
-			// 参加者がいなくなったらルームを削除する
-			if len(r.connections.streams) == 0 {
-				rm.mu.Lock()
-				log.Println("room " + roomId + " is closed")
-				delete(rm.rooms, roomId)
-				rm.mu.Unlock()
+		case <-ctx.Done():
+			done = true
+		}
+	}
+	// 退出やルームを閉じたことによる終了の場合は、最後に通知したイベントまで送ってから終了する
+	if isGracefulStop(context.Cause(ctx)) {
+		for flushed := false; !flushed; {
+			select {
+			case res := <-queue:
+				if err := stream.Send(res); err != nil {
+					s.metrics.broadcastFailures.WithLabelValues(failureSendError).Inc()
+					loggerFrom(ctx).Info("failed to send message", "error", err)
+					flushed = true
+				}
+			default:
+				flushed = true
 			}
-
-			return nil
-		default:
-			time.Sleep(1 * time.Second)
 		}
 	}
+	loggerFrom(ctx).Info("disconnected", "cause", context.Cause(ctx))
+
+	// ctxはキャンセル済みなので、ルームの更新にはロガーだけを引き継いだcontextを使う
+	// 再接続した新しいストリームに置き換えられている場合や、ルームが既に閉じられている場合は何もしない
+	bg := context.WithoutCancel(ctx)
+	err = r.do(bg, func(st *roomState) error {
+		st.disconnect(bg, join.name, stream)
+		return nil
+	})
+	if err != nil && !errors.Is(err, ErrRoomNotFound) {
+		loggerFrom(ctx).Error("failed to disconnect", "error", err)
+	}
+
+	if cause := context.Cause(ctx); errors.Is(cause, ErrSlowConsumer) {
+		return connect.NewError(connect.CodeResourceExhausted, cause)
+	}
+	return nil
 }
 
-func (s *pokerServer) Vote(_ context.Context, req *connect.Request[pokerv1.VoteRequest]) (*connect.Response[pokerv1.VoteResponse], error) {
-	log.Printf("Vote function was invoked with a request from %s with vote \"%d\"\n", req.Msg.Id, req.Msg.Vote)
+// do roomIdのルームのゴルーチンでfを実行する。
+// 呼び出し元のストリームを他のインスタンスが受け持っていても、このインスタンスでルームの管理を始めて処理する。
+// 既に閉じられたルームはNotFoundとして扱う。
+func (s *pokerServer) do(ctx context.Context, roomId string, f func(st *roomState) error) error {
+	err := s.rooms.do(ctx, roomId, f)
+	if err == nil || connect.CodeOf(err) != connect.CodeUnknown {
+		return err
+	}
+	return roomNotFoundOr(roomId, err)
+}
 
-	r, ok := rm.rooms[req.Msg.RoomId]
-	if !ok {
-		err := fmt.Errorf("room %s not found", req.Msg.RoomId)
-		log.Println(err)
-		return nil, connect.NewError(
+// roomNotFoundOr ストアから返ってきたエラーをconnectのエラーに変換する
+func roomNotFoundOr(roomId string, err error) error {
+	if errors.Is(err, ErrRoomNotFound) {
+		return connect.NewError(
 			connect.CodeNotFound,
-			err,
+			fmt.Errorf("room %s not found", roomId),
 		)
 	}
+	return connect.NewError(connect.CodeInternal, err)
+}
 
-	if req.Msg.Vote == -1 {
-		r.connections.Broadcast(req.Msg.Id, pokerv1.MessageType_MESSAGE_TYPE_RESET_VOTE)
-		r.voteMap.Delete(req.Msg.Id)
-	} else if req.Msg.Vote <= 0 {
-		err := fmt.Errorf("invalid vote %d", req.Msg.Vote)
-		log.Println(err)
-		return nil, connect.NewError(
-			connect.CodeInvalidArgument,
-			err,
-		)
-	} else {
-		r.connections.Broadcast(req.Msg.Id, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
-		r.voteMap.Store(req.Msg.Id, req.Msg.Vote)
+func (s *pokerServer) Vote(ctx context.Context, req *connect.Request[pokerv1.VoteRequest]) (*connect.Response[pokerv1.VoteResponse], error) {
+	loggerFrom(ctx).Debug("Vote function was invoked", "vote", req.Msg.Vote, "card", req.Msg.Card)
+
+	reset := req.Msg.Vote == -1 && req.Msg.Card == ""
+	var label string
+	if !reset {
+		var err error
+		label, err = cardLabel(req.Msg)
+		if err != nil {
+			return nil, connect.NewError(
+				connect.CodeInvalidArgument,
+				err,
+			)
+		}
+	}
+
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		record, err := st.requireParticipant(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+		if record.roleOf(req.Msg.Id) == pokerv1.Role_ROLE_OBSERVER {
+			return connect.NewError(
+				connect.CodePermissionDenied,
+				ErrObserverCannotVote,
+			)
+		}
+
+		if reset {
+			if err := st.store.ClearVote(ctx, st.id, req.Msg.Id); err != nil {
+				return roomNotFoundOr(st.id, err)
+			}
+			st.broadcast(newResetVoteEvent(req.Msg.Id))
+			st.checkAutoReveal(ctx)
+			st.touch(ctx)
+			return nil
+		}
+
+		if _, _, ok := record.Deck.find(label); !ok {
+			return connect.NewError(
+				connect.CodeInvalidArgument,
+				fmt.Errorf("%w: %s", ErrCardNotInDeck, label),
+			)
+		}
+		if err := st.store.PutVote(ctx, st.id, req.Msg.Id, label); err != nil {
+			return roomNotFoundOr(st.id, err)
+		}
+		st.metrics.votesCast.Inc()
+		st.broadcast(newVoteEvent(req.Msg.Id))
+		st.checkAutoReveal(ctx)
+		st.touch(ctx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
-	r.currentUsedAt = time.Now()
 
 	return connect.NewResponse(&pokerv1.VoteResponse{
 		Message: "voted",
 	}), nil
 }
 
-func (s *pokerServer) ShowVotes(_ context.Context, req *connect.Request[pokerv1.ShowVotesRequest]) (*connect.Response[pokerv1.ShowVotesResponse], error) {
-	log.Println("ShowVotes function was invoked with a request from " + req.Msg.Id)
+func (s *pokerServer) ShowVotes(ctx context.Context, req *connect.Request[pokerv1.ShowVotesRequest]) (*connect.Response[pokerv1.ShowVotesResponse], error) {
+	loggerFrom(ctx).Debug("ShowVotes function was invoked")
 
-	r, ok := rm.rooms[req.Msg.RoomId]
-	if !ok {
-		err := fmt.Errorf("room %s not found", req.Msg.RoomId)
-		log.Println(err)
-		return nil, connect.NewError(
-			connect.CodeNotFound,
-			err,
-		)
+	res := &pokerv1.ShowVotesResponse{
+		Message: "no votes",
 	}
-
-	votes := make(map[string]float32, len(r.connections.streams)+1)
-	var sum int32
-	var l int
-	r.voteMap.Range(func(key, value any) bool {
-		id, ok := key.(string)
-		if !ok {
-			return true
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		record, err := st.authorizeFacilitator(ctx, req.Msg.Id)
+		if err != nil {
+			return err
 		}
-		vote, ok := value.(int32)
-		if !ok {
-			return true
+		if len(record.Votes) == 0 {
+			return nil
 		}
-		votes[id] = float32(vote)
-		sum += vote
-		l++
-		return true
-	})
 
-	if sum == 0 {
-		return connect.NewResponse(&pokerv1.ShowVotesResponse{
-			Message: "no votes",
-		}), nil
+		res.Message = "accepted"
+		res.Votes, res.Statistics = st.reveal(ctx, record)
+		return nil
+	})
+	if err != nil {
+		return nil, err
 	}
 
-	votes[AVERAGE] = float32(sum) / float32(l)
-	b, err := json.Marshal(votes)
+	return connect.NewResponse(res), nil
+}
+
+func (s *pokerServer) NewGame(ctx context.Context, req *connect.Request[pokerv1.NewGameRequest]) (*connect.Response[pokerv1.NewGameResponse], error) {
+	loggerFrom(ctx).Debug("NewGame function was invoked")
+
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		record, err := st.authorizeFacilitator(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+		st.clearTimer(ctx, record)
+		st.cancelAutoReveal()
+		if err := st.endRound(ctx, record, time.Now()); err != nil {
+			return err
+		}
+
+		st.logger(ctx).Info("new game start")
+		st.broadcast(newNewGameEvent("new game start"))
+
+		// 次のストーリーの見積もりに進む
+		if len(record.Stories) > 0 {
+			return st.saveStories(ctx, record.Stories, nextStory(record.Stories, record.CurrentStory))
+		}
+		st.touch(ctx)
+		return nil
+	})
 	if err != nil {
-		log.Println("failed to marshal votes.", err)
+		return nil, err
 	}
-	r.connections.Broadcast(string(b), pokerv1.MessageType_MESSAGE_TYPE_SHOW_VOTES)
-	r.currentUsedAt = time.Now()
 
-	return connect.NewResponse(&pokerv1.ShowVotesResponse{
+	return connect.NewResponse(&pokerv1.NewGameResponse{
 		Message: "accepted",
 	}), nil
 }
 
-func (s *pokerServer) NewGame(_ context.Context, req *connect.Request[pokerv1.NewGameRequest]) (*connect.Response[pokerv1.NewGameResponse], error) {
-	log.Println("NewGame function was invoked with a request from " + req.Msg.Id)
+// reveal 現在のラウンドの投票を公開し、履歴に残す。動いているタイマーと、予約した自動での公開は取り消す。
+func (st *roomState) reveal(ctx context.Context, record *RoomRecord) ([]*pokerv1.VoteEntry, *pokerv1.VoteStatistics) {
+	st.clearTimer(ctx, record)
+	st.cancelAutoReveal()
+	votes := voteEntries(record.Votes, record.Deck)
+	stats := computeStatistics(record.Votes, record.Deck)
+	st.recordRound(ctx, record, time.Now())
+	st.metrics.reveals.Inc()
+	st.broadcast(newShowVotesEvent(votes, stats))
+	st.touch(ctx)
+	return votes, stats
+}
 
-	r, ok := rm.rooms[req.Msg.RoomId]
-	if !ok {
-		err := fmt.Errorf("room %s not found", req.Msg.RoomId)
-		log.Println(err)
-		return nil, connect.NewError(
-			connect.CodeNotFound,
-			err,
-		)
+// sweep lastUsedAtより前から使われていないルームを閉じる
+func (s *pokerServer) sweep(ctx context.Context, lastUsedAt time.Time) {
+	rooms, err := s.store.ListRooms(ctx)
+	if err != nil {
+		slog.Error("failed to list rooms", "error", err)
+		return
 	}
+	for _, record := range rooms {
+		if !record.LastUsedAt.Before(lastUsedAt) {
+			continue
+		}
+		logger := slog.With(slog.String("room_id", record.ID))
+		logger.Info("room is closed because it is not used for a long time")
+		s.metrics.roomsReaped.Inc()
 
-	var m sync.Map
-	r.voteMap = &m
-	log.Println("new game start in Room " + req.Msg.RoomId)
-	r.connections.Broadcast("new game start", pokerv1.MessageType_MESSAGE_TYPE_NEW_GAME)
-	r.currentUsedAt = time.Now()
+		// 接続の管理を始めていないルームは、ストアから削除して、他のインスタンスに閉じたことを知らせるだけでよい
+		r, ok := s.rooms.get(record.ID)
+		if !ok {
+			if err := s.store.DeleteRoom(ctx, record.ID); err != nil && !errors.Is(err, ErrRoomNotFound) {
+				logger.Error("failed to delete room", "error", err)
+			}
+			if err := s.rooms.publish(ctx, record.ID, newRoomClosedEvent("")); err != nil {
+				logger.Error("failed to publish room closed event", "error", err)
+			}
+			continue
+		}
+		err := r.do(ctx, func(st *roomState) error {
+			st.close(ctx, "")
+			return nil
+		})
+		if err != nil && !errors.Is(err, ErrRoomNotFound) {
+			logger.Error("failed to close room", "error", err)
+		}
+	}
+}
 
-	return connect.NewResponse(&pokerv1.NewGameResponse{
-		Message: "accepted",
-	}), nil
+// newServeMux サービスのハンドラを登録したServeMuxを返す
+func newServeMux(s *pokerServer) *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.Handle(pokerv1connect.NewPlanningPokerServiceHandler(
+		s,
+		connect.WithInterceptors(
+			newLoggingInterceptor(slog.Default()),
+			&metricsInterceptor{metrics: s.metrics},
+			newSessionInterceptor(s.store),
+		),
+	))
+	// ヘルスチェックとリフレクションは、プローブのたびにログが出ないようにインターセプタを付けない
+	mux.Handle(grpchealth.NewHandler(&healthChecker{server: s}))
+	reflector := grpcreflect.NewStaticReflector(
+		pokerv1connect.PlanningPokerServiceName,
+		grpchealth.HealthV1ServiceName,
+	)
+	mux.Handle(grpcreflect.NewHandlerV1(reflector))
+	mux.Handle(grpcreflect.NewHandlerV1Alpha(reflector))
+	mux.Handle(metricsPath, s.metrics.handler())
+	mux.HandleFunc(exportPath, s.handleExport)
+	mux.HandleFunc(livenessPath, handleLiveness)
+	mux.HandleFunc(readinessPath, s.handleReadiness)
+	return mux
 }
 
 func main() {
-	// 1時間に1回、使われていないルームがあるか確認する
-	// 6時間使われていないルームは削除する
+	cfg, err := loadConfig(os.Args[1:], os.LookupEnv)
+	if errors.Is(err, flag.ErrHelp) {
+		return
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+
+	logger, err := newLogger(os.Stderr, cfg.LogLevel, cfg.LogFormat)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(2)
+	}
+	slog.SetDefault(logger)
+	logger.Info("effective config", "config", cfg)
+
+	var store RoomStore
+	switch {
+	case cfg.StoreURL != "":
+		rs, err := NewRedisRoomStore(cfg.StoreURL)
+		if err != nil {
+			logger.Error("failed to configure store", "error", err)
+			os.Exit(1)
+		}
+		logger.Info("rooms are stored in redis", "store_url", cfg.value("store-url"))
+		store = rs
+	case cfg.StoreFile != "":
+		fs, err := NewFileRoomStore(cfg.StoreFile)
+		if err != nil {
+			logger.Error("failed to open store", "error", err)
+			os.Exit(1)
+		}
+		logger.Info("rooms are persisted", "path", cfg.StoreFile)
+		store = fs
+	default:
+		store = NewMemoryRoomStore()
+	}
+
+	var bus Bus
+	if cfg.BusURL == "" {
+		bus = NewLocalBus()
+	} else {
+		rb, err := NewRedisBus(cfg.BusURL)
+		if err != nil {
+			logger.Error("failed to configure bus", "error", err)
+			os.Exit(1)
+		}
+		logger.Info("room events are shared with other instances", "bus_url", cfg.value("bus-url"))
+		bus = rb
+	}
+
+	server := newPokerServer(store, bus, cfg)
+
+	ctx, stop := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer stop()
+
+	// sweep-intervalごとに、idle-timeoutより長く使われていないルームを削除する
 	go func() {
+		ticker := time.NewTicker(cfg.SweepInterval)
+		defer ticker.Stop()
 		for {
-			time.Sleep(1 * time.Hour)
-			rm.mu.Lock()
-			for id, r := range rm.rooms {
-				if time.Since(r.currentUsedAt) > 6*time.Hour {
-					log.Println("room " + id + " is closed because it is not used for a long time")
-					delete(rm.rooms, id)
-				}
+			select {
+			case <-ticker.C:
+				server.sweep(ctx, time.Now().Add(-cfg.IdleTimeout))
+			case <-ctx.Done():
+				return
 			}
-			rm.mu.Unlock()
 		}
 	}()
 
 	corsHandler := cors.New(cors.Options{
 		AllowedMethods: []string{"GET", "POST"},
-		AllowedOrigins: []string{"*"},
+		AllowedOrigins: cfg.AllowedOrigins,
 		AllowedHeaders: []string{
 			"Accept-Encoding",
 			"Content-Encoding",
@@ -320,85 +638,59 @@ func main() {
 			"Grpc-Timeout",             // Used for gRPC-web
 			"X-Grpc-Web",               // Used for gRPC-web
 			"X-User-Agent",             // Used for gRPC-web
+			sessionHeader,
+			requestIDHeader,
 		},
 		ExposedHeaders: []string{
 			"Content-Encoding",         // Unused in web browsers, but added for future-proofing
 			"Connect-Content-Encoding", // Unused in web browsers, but added for future-proofing
 			"Grpc-Status",              // Required for gRPC-web
 			"Grpc-Message",             // Required for gRPC-web
+			sessionHeader,
+			requestIDHeader,
 		},
 	})
 
-	server := &pokerServer{}
-	mux := http.NewServeMux()
-	mux.Handle(pokerv1connect.NewPlanningPokerServiceHandler(server))
-	handler := corsHandler.Handler(mux)
+	handler := corsHandler.Handler(newServeMux(server))
 
-	log.Println("Listening on :8080")
-	err := http.ListenAndServe(":8080", handler)
+	srv, err := newHTTPServer(cfg, handler)
 	if err != nil {
-		log.Fatal(err)
+		logger.Error("failed to configure server", "error", err)
+		os.Exit(1)
 	}
-}
-
-type RoomMap struct {
-	mu    sync.Mutex
-	rooms map[string]*Room
-}
-
-type Room struct {
-	id            string
-	connections   ConnectionMap
-	voteMap       *sync.Map
-	currentUsedAt time.Time
-}
-
-// ConnectionMap Connectionの状態を保持する構造体。
-// この構造体は、Connect関数で生成され、Disconnect関数で削除される。
-// streamsは、クライアントのIDをキーとして、クライアントとの接続を保持する。
-type ConnectionMap struct {
-	mu      sync.Mutex
-	streams map[string]StreamState
-}
-
-type StreamState struct {
-	ctx    context.Context
-	stream *connect.ServerStream[pokerv1.ConnectResponse]
-}
+	ln, err := net.Listen("tcp", cfg.ListenAddr)
+	if err != nil {
+		logger.Error("failed to listen", "error", err)
+		os.Exit(1)
+	}
+	serveErr := make(chan error, 1)
+	go func() {
+		logger.Info("listening", "addr", ln.Addr().String(), "tls", srv.TLSConfig != nil)
+		serveErr <- serve(srv, ln)
+	}()
 
-var ErrAlreadyConnected = errors.New("already connected")
+	select {
+	case err := <-serveErr:
+		store.Close()
+		logger.Error("failed to serve", "error", err)
+		os.Exit(1)
+	case <-ctx.Done():
+	}
 
-func (cm *ConnectionMap) Connect(ctx context.Context, stream *connect.ServerStream[pokerv1.ConnectResponse], name string) error {
-	cm.mu.Lock()
-	if _, ok := cm.streams[name]; ok {
-		cm.mu.Unlock()
-		return ErrAlreadyConnected
+	// もう一度シグナルを受けたら、待たずに終了する
+	stop()
+	logger.Info("shutting down", "timeout", cfg.ShutdownTimeout)
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), cfg.ShutdownTimeout)
+	defer cancel()
+	err = server.shutdown(shutdownCtx, srv)
+	if err := store.Close(); err != nil {
+		logger.Error("failed to close store", "error", err)
 	}
-	cm.streams[name] = StreamState{
-		ctx:    ctx,
-		stream: stream,
+	if err := bus.Close(); err != nil {
+		logger.Error("failed to close bus", "error", err)
 	}
-	cm.mu.Unlock()
-	return nil
-}
-
-func (cm *ConnectionMap) Disconnect(name string) {
-	cm.mu.Lock()
-	cm.streams[name].ctx.Done()
-	delete(cm.streams, name)
-	cm.mu.Unlock()
-}
-
-func (cm *ConnectionMap) Broadcast(message string, mt pokerv1.MessageType) {
-	cm.mu.Lock()
-	for id, state := range cm.streams {
-		err := state.stream.Send(&pokerv1.ConnectResponse{
-			Type:    mt,
-			Message: message,
-		})
-		if err != nil {
-			log.Println("failed to send message to "+id, err)
-		}
+	if err != nil {
+		os.Exit(1)
 	}
-	cm.mu.Unlock()
+	logger.Info("server stopped")
 }
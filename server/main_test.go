@@ -0,0 +1,2046 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"connectrpc.com/grpchealth"
+	"connectrpc.com/grpcreflect"
+	"github.com/alicebob/miniredis/v2"
+	"github.com/google/uuid"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+	"github.com/machimachida/grpc-planning-poker/gen/proto/v1/pokerv1connect"
+)
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+
+	return newTestServerWithConfig(t, defaultConfig())
+}
+
+func newTestServerWithConfig(t *testing.T, cfg Config) *httptest.Server {
+	t.Helper()
+
+	return newTestServerWithStore(t, NewMemoryRoomStore(), cfg)
+}
+
+func newTestServerWithStore(t *testing.T, store RoomStore, cfg Config) *httptest.Server {
+	t.Helper()
+
+	server := newPokerServer(store, NewLocalBus(), cfg)
+	ts := httptest.NewUnstartedServer(newServeMux(server))
+	ts.EnableHTTP2 = true
+	ts.StartTLS()
+	t.Cleanup(ts.Close)
+	return ts
+}
+
+func newTestClient(t *testing.T) pokerv1connect.PlanningPokerServiceClient {
+	t.Helper()
+
+	ts := newTestServer(t)
+	return pokerv1connect.NewPlanningPokerServiceClient(ts.Client(), ts.URL)
+}
+
+// receiver ストリームから受信したイベントをチャネルに流す
+type receiver struct {
+	events chan *pokerv1.ConnectResponse
+	done   chan struct{}
+}
+
+func receive(stream *connect.ServerStreamForClient[pokerv1.ConnectResponse]) *receiver {
+	r := &receiver{
+		events: make(chan *pokerv1.ConnectResponse, 64),
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(r.done)
+		for stream.Receive() {
+			r.events <- stream.Msg()
+		}
+	}()
+	return r
+}
+
+// withSession セッショントークンをヘッダに付けたリクエストを作る
+func withSession[T any](msg *T, token string) *connect.Request[T] {
+	req := connect.NewRequest(msg)
+	req.Header().Set(sessionHeader, token)
+	return req
+}
+
+func (r *receiver) next(t *testing.T) *pokerv1.ConnectResponse {
+	t.Helper()
+	select {
+	case res := <-r.events:
+		return res
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for an event")
+		return nil
+	}
+}
+
+func (r *receiver) expect(t *testing.T, mt pokerv1.MessageType) *pokerv1.ConnectResponse {
+	t.Helper()
+	res := r.next(t)
+	if res.Type != mt {
+		t.Fatalf("expected %v, got %v (%v)", mt, res.Type, res.Event)
+	}
+	return res
+}
+
+func TestConnectResume(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	taroStream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "resume"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(taroStream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	hanakoStream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "resume"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hanako := receive(hanakoStream)
+	hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	hanakoToken := hanakoStream.ResponseHeader().Get(sessionHeader)
+	joined := hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	// Hanakoの接続が不安定で、このイベントを受け取れなかったとする
+	_, err = client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Taro", RoomId: "resume", Vote: 3}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	voted := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+	if voted.Sequence != joined.Sequence+1 {
+		t.Fatalf("sequence is not continuous: %d -> %d", joined.Sequence, voted.Sequence)
+	}
+
+	// 他人のトークンでは置き換えられない
+	hijack, err := client.Connect(ctx, withSession(&pokerv1.ConnectRequest{
+		Id:          "Hanako",
+		RoomId:      "resume",
+		ResumeAfter: joined.Sequence,
+	}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if hijack.Receive() {
+		t.Fatalf("unexpected event %v", hijack.Msg())
+	}
+	if connect.CodeOf(hijack.Err()) != connect.CodeUnauthenticated {
+		t.Fatalf("expected unauthenticated, got %v", hijack.Err())
+	}
+
+	// 古いストリームが残ったままでも、resume_afterを指定すれば置き換えられる
+	resumeCtx, resumeCancel := context.WithCancel(ctx)
+	resumedStream, err := client.Connect(resumeCtx, withSession(&pokerv1.ConnectRequest{
+		Id:          "Hanako",
+		RoomId:      "resume",
+		ResumeAfter: joined.Sequence,
+	}, hanakoToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resumed := receive(resumedStream)
+	replayed := resumed.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+	if replayed.Sequence != voted.Sequence || replayed.GetVoteCast().GetParticipantId() != "Taro" {
+		t.Fatalf("unexpected replayed event %v", replayed)
+	}
+
+	select {
+	case <-hanako.done:
+	case <-time.After(5 * time.Second):
+		t.Fatal("stale stream was not closed")
+	}
+
+	// 置き換えでは退出を通知しない
+	_, err = client.NewGame(ctx, withSession(&pokerv1.NewGameRequest{Id: "Taro", RoomId: "resume"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_NEW_GAME)
+	resumed.expect(t, pokerv1.MessageType_MESSAGE_TYPE_NEW_GAME)
+
+	// resume_afterなしでは同じ名前で接続できない
+	dup, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "resume"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dup.Receive() {
+		t.Fatalf("unexpected event %v", dup.Msg())
+	}
+	if connect.CodeOf(dup.Err()) != connect.CodeAlreadyExists {
+		t.Fatalf("expected already exists, got %v", dup.Err())
+	}
+
+	// 切断されただけのファシリテータは、ロックされていても接続し直せて、ロールもそのまま戻る
+	_, err = client.SetRole(ctx, withSession(&pokerv1.SetRoleRequest{Id: "Taro", RoomId: "resume", ParticipantId: "Hanako", Role: pokerv1.Role_ROLE_FACILITATOR}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_ROLE_CHANGED)
+	promoted := resumed.expect(t, pokerv1.MessageType_MESSAGE_TYPE_ROLE_CHANGED)
+	hanakoToken = resumedStream.ResponseHeader().Get(sessionHeader)
+	resumeCancel()
+	<-resumed.done
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LEAVE)
+	_, err = client.SetRoomLock(ctx, withSession(&pokerv1.SetRoomLockRequest{Id: "Taro", RoomId: "resume", Locked: true}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LOCK_CHANGED)
+
+	resumedStream, err = client.Connect(ctx, withSession(&pokerv1.ConnectRequest{
+		Id:          "Hanako",
+		RoomId:      "resume",
+		ResumeAfter: promoted.Sequence,
+	}, hanakoToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resumed = receive(resumedStream)
+	resumed.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LEAVE)
+	resumed.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LOCK_CHANGED)
+	resumed.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+	hanakoToken = resumedStream.ResponseHeader().Get(sessionHeader)
+	if role := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN).GetParticipantJoined().GetRole(); role != pokerv1.Role_ROLE_FACILITATOR {
+		t.Fatalf("role must be kept across reconnects, got %v", role)
+	}
+	_, err = client.NewGame(ctx, withSession(&pokerv1.NewGameRequest{Id: "Hanako", RoomId: "resume"}, hanakoToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestVoteWithDeck(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{
+		Id:     "Taro",
+		RoomId: "deck",
+		Deck: &pokerv1.Deck{
+			Preset: pokerv1.DeckPreset_DECK_PRESET_CUSTOM,
+			Cards: []*pokerv1.Card{
+				{Label: "S", Value: proto.Float64(1)},
+				{Label: "M", Value: proto.Float64(2)},
+				{Label: "?"},
+			},
+		},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(stream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := stream.ResponseHeader().Get(sessionHeader)
+	status := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	if len(status.GetRoomStatus().GetDeck().GetCards()) != 3 {
+		t.Fatalf("unexpected deck %v", status.GetRoomStatus().GetDeck())
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	hanakoStream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "deck"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hanako := receive(hanakoStream)
+	hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	hanakoToken := hanakoStream.ResponseHeader().Get(sessionHeader)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	_, err = client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Taro", RoomId: "deck", Card: "XL"}, taroToken))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+	_, err = client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Taro", RoomId: "deck", Card: "M"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Hanako", RoomId: "deck", Card: "?"}, hanakoToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.ShowVotes(ctx, withSession(&pokerv1.ShowVotesRequest{Id: "Taro", RoomId: "deck"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+	revealed := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SHOW_VOTES).GetVotesRevealed()
+	if len(revealed.Votes) != 2 {
+		t.Fatalf("non-numeric cards must be revealed too: %v", revealed.Votes)
+	}
+	if revealed.Statistics.Count != 1 || revealed.Statistics.Average != 2 {
+		t.Fatalf("statistics must be computed over numeric cards only: %v", revealed.Statistics)
+	}
+}
+
+func TestFacilitatorPermissions(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	taroStream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "facilitator"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(taroStream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+	status := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	if role := status.GetRoomStatus().GetParticipants()[0].GetRole(); role != pokerv1.Role_ROLE_FACILITATOR {
+		t.Fatalf("creator must be the facilitator, got %v", role)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	hanakoStream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "facilitator"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hanako := receive(hanakoStream)
+	hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	hanakoToken := hanakoStream.ResponseHeader().Get(sessionHeader)
+	joined := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+	if role := joined.GetParticipantJoined().GetRole(); role != pokerv1.Role_ROLE_VOTER {
+		t.Fatalf("participant must join as a voter, got %v", role)
+	}
+
+	_, err = client.ShowVotes(ctx, withSession(&pokerv1.ShowVotesRequest{Id: "Hanako", RoomId: "facilitator"}, hanakoToken))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+	_, err = client.NewGame(ctx, withSession(&pokerv1.NewGameRequest{Id: "Hanako", RoomId: "facilitator"}, hanakoToken))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+
+	_, err = client.TransferFacilitator(ctx, withSession(&pokerv1.TransferFacilitatorRequest{Id: "Taro", RoomId: "facilitator", ParticipantId: "Hanako"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	changed := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_ROLE_CHANGED).GetRoleChanged()
+	if changed.ParticipantId != "Hanako" || changed.Role != pokerv1.Role_ROLE_FACILITATOR {
+		t.Fatalf("unexpected role change %v", changed)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_ROLE_CHANGED)
+
+	_, err = client.NewGame(ctx, withSession(&pokerv1.NewGameRequest{Id: "Taro", RoomId: "facilitator"}, taroToken))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+	_, err = client.NewGame(ctx, withSession(&pokerv1.NewGameRequest{Id: "Hanako", RoomId: "facilitator"}, hanakoToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// ファシリテータを共有した後は、自分を降格できる
+	_, err = client.SetRole(ctx, withSession(&pokerv1.SetRoleRequest{Id: "Hanako", RoomId: "facilitator", ParticipantId: "Taro", Role: pokerv1.Role_ROLE_FACILITATOR}, hanakoToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.SetRole(ctx, withSession(&pokerv1.SetRoleRequest{Id: "Hanako", RoomId: "facilitator", ParticipantId: "Hanako", Role: pokerv1.Role_ROLE_VOTER}, hanakoToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.SetRole(ctx, withSession(&pokerv1.SetRoleRequest{Id: "Taro", RoomId: "facilitator", ParticipantId: "Taro", Role: pokerv1.Role_ROLE_VOTER}, taroToken))
+	if connect.CodeOf(err) != connect.CodeFailedPrecondition {
+		t.Fatalf("expected failed precondition, got %v", err)
+	}
+
+	// 外されたファシリテータが同じ名前で参加し直しても、ロールは戻らない
+	_, err = client.SetRole(ctx, withSession(&pokerv1.SetRoleRequest{Id: "Taro", RoomId: "facilitator", ParticipantId: "Hanako", Role: pokerv1.Role_ROLE_FACILITATOR}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.KickParticipant(ctx, withSession(&pokerv1.KickParticipantRequest{Id: "Taro", RoomId: "facilitator", ParticipantId: "Hanako"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-hanako.done
+	hanakoStream, err = client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "facilitator"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hanako = receive(hanakoStream)
+	status = hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	hanakoToken = hanakoStream.ResponseHeader().Get(sessionHeader)
+	for _, p := range status.GetRoomStatus().GetParticipants() {
+		if p.ParticipantId == "Hanako" && p.Role != pokerv1.Role_ROLE_VOTER {
+			t.Fatalf("kicked facilitator must rejoin as a voter, got %v", p.Role)
+		}
+	}
+	_, err = client.CloseRoom(ctx, withSession(&pokerv1.CloseRoomRequest{Id: "Hanako", RoomId: "facilitator"}, hanakoToken))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+}
+
+func TestSessionToken(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	taroStream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "session"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(taroStream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+	if taroToken == "" {
+		t.Fatal("session token is not issued")
+	}
+
+	hanakoStream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "session"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hanako := receive(hanakoStream)
+	hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	hanakoToken := hanakoStream.ResponseHeader().Get(sessionHeader)
+	if hanakoToken == "" || hanakoToken == taroToken {
+		t.Fatalf("unexpected session token %q", hanakoToken)
+	}
+
+	otherStream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "other"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	other := receive(otherStream)
+	other.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	otherToken := otherStream.ResponseHeader().Get(sessionHeader)
+
+	tests := []struct {
+		name string
+		req  *connect.Request[pokerv1.VoteRequest]
+	}{
+		{name: "no token", req: connect.NewRequest(&pokerv1.VoteRequest{Id: "Taro", RoomId: "session", Card: "3"})},
+		{name: "someone else's token", req: withSession(&pokerv1.VoteRequest{Id: "Taro", RoomId: "session", Card: "3"}, hanakoToken)},
+		{name: "token of another room", req: withSession(&pokerv1.VoteRequest{Id: "Taro", RoomId: "session", Card: "3"}, otherToken)},
+		{name: "not connected", req: withSession(&pokerv1.VoteRequest{Id: "Ghost", RoomId: "session", Card: "3"}, taroToken)},
+		{name: "room not found", req: withSession(&pokerv1.VoteRequest{Id: "Taro", RoomId: "nowhere", Card: "3"}, taroToken)},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := client.Vote(ctx, tt.req)
+			if connect.CodeOf(err) != connect.CodeUnauthenticated {
+				t.Fatalf("expected unauthenticated, got %v", err)
+			}
+		})
+	}
+
+	_, err = client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Taro", RoomId: "session", Card: "3"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestConcurrentVotes(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	taroStream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "concurrent"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(taroStream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+
+	const participants = 20
+	var wg sync.WaitGroup
+	errs := make(chan error, participants)
+	for i := 0; i < participants; i++ {
+		wg.Add(1)
+		go func(name string) {
+			defer wg.Done()
+			stream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: name, RoomId: "concurrent"}))
+			if err != nil {
+				errs <- err
+				return
+			}
+			if !stream.Receive() {
+				errs <- stream.Err()
+				return
+			}
+			token := stream.ResponseHeader().Get(sessionHeader)
+			go func() {
+				for stream.Receive() {
+				}
+			}()
+			for _, card := range []string{"1", "3", "5"} {
+				_, err := client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: name, RoomId: "concurrent", Card: card}, token))
+				if err != nil {
+					errs <- err
+					return
+				}
+			}
+		}(fmt.Sprintf("voter-%02d", i))
+	}
+	wg.Wait()
+	close(errs)
+	for err := range errs {
+		t.Fatal(err)
+	}
+
+	res, err := client.ShowVotes(ctx, withSession(&pokerv1.ShowVotesRequest{Id: "Taro", RoomId: "concurrent"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(res.Msg.Votes) != participants {
+		t.Fatalf("expected %d votes, got %d", participants, len(res.Msg.Votes))
+	}
+	if !res.Msg.Statistics.Consensus || res.Msg.Statistics.Modes[0] != "5" {
+		t.Fatalf("every participant must end up with the last vote: %v", res.Msg.Statistics)
+	}
+}
+
+func TestStoryBacklog(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	taroStream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "backlog"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(taroStream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	var ids []string
+	for _, title := range []string{"Login", "Logout", "Sign up"} {
+		res, err := client.AddStory(ctx, withSession(&pokerv1.AddStoryRequest{Id: "Taro", RoomId: "backlog", Title: title}, taroToken))
+		if err != nil {
+			t.Fatal(err)
+		}
+		ids = append(ids, res.Msg.Story.Id)
+		changed := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STORIES_CHANGED).GetStoriesChanged()
+		if changed.CurrentStoryId != ids[0] {
+			t.Fatalf("the first story must be selected, got %q", changed.CurrentStoryId)
+		}
+	}
+	_, err = client.AddStory(ctx, withSession(&pokerv1.AddStoryRequest{Id: "Taro", RoomId: "backlog", Title: "  "}, taroToken))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+
+	// 新しく参加したユーザにも同じストーリーが見える
+	hanakoStream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "backlog"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hanako := receive(hanakoStream)
+	status := hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS).GetRoomStatus()
+	if len(status.Stories) != 3 || status.CurrentStoryId != ids[0] {
+		t.Fatalf("unexpected stories in status %v", status)
+	}
+	hanakoToken := hanakoStream.ResponseHeader().Get(sessionHeader)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	_, err = client.AddStory(ctx, withSession(&pokerv1.AddStoryRequest{Id: "Hanako", RoomId: "backlog", Title: "Logout"}, hanakoToken))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+
+	_, err = client.ReorderStories(ctx, withSession(&pokerv1.ReorderStoriesRequest{Id: "Taro", RoomId: "backlog", StoryIds: []string{ids[2], ids[0]}}, taroToken))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+	_, err = client.ReorderStories(ctx, withSession(&pokerv1.ReorderStoriesRequest{Id: "Taro", RoomId: "backlog", StoryIds: []string{ids[0], ids[2], ids[1]}}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STORIES_CHANGED)
+
+	accepted, err := client.AcceptEstimate(ctx, withSession(&pokerv1.AcceptEstimateRequest{Id: "Taro", RoomId: "backlog", Estimate: "5"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if accepted.Msg.Story.Id != ids[0] || accepted.Msg.Story.Estimate != "5" {
+		t.Fatalf("estimate must be stored on the current story: %v", accepted.Msg.Story)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STORIES_CHANGED)
+
+	// NewGameで、並べ替えた順に次のストーリーへ進む
+	_, err = client.NewGame(ctx, withSession(&pokerv1.NewGameRequest{Id: "Taro", RoomId: "backlog"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_NEW_GAME)
+	changed := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STORIES_CHANGED)
+	if changed.GetStoriesChanged().CurrentStoryId != ids[2] || changed.Message != "Sign up" {
+		t.Fatalf("expected to advance to %s, got %v", ids[2], changed)
+	}
+
+	_, err = client.RemoveStory(ctx, withSession(&pokerv1.RemoveStoryRequest{Id: "Taro", RoomId: "backlog", StoryId: ids[2]}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	removed := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STORIES_CHANGED).GetStoriesChanged()
+	if len(removed.Stories) != 2 || removed.CurrentStoryId != "" {
+		t.Fatalf("unexpected stories after removal %v", removed)
+	}
+	_, err = client.SelectStory(ctx, withSession(&pokerv1.SelectStoryRequest{Id: "Taro", RoomId: "backlog", StoryId: ids[2]}, taroToken))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+	_, err = client.SelectStory(ctx, withSession(&pokerv1.SelectStoryRequest{Id: "Taro", RoomId: "backlog", StoryId: ids[1]}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STORIES_CHANGED).GetStoriesChanged().CurrentStoryId; got != ids[1] {
+		t.Fatalf("expected %s to be selected, got %s", ids[1], got)
+	}
+}
+
+func TestRoomHistory(t *testing.T) {
+	ts := newTestServer(t)
+	client := pokerv1connect.NewPlanningPokerServiceClient(ts.Client(), ts.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	taroStream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "history"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(taroStream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	story, err := client.AddStory(ctx, withSession(&pokerv1.AddStoryRequest{Id: "Taro", RoomId: "history", Title: "Login | SSO"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STORIES_CHANGED)
+
+	for _, card := range []string{"3", "5"} {
+		_, err = client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Taro", RoomId: "history", Card: card}, taroToken))
+		if err != nil {
+			t.Fatal(err)
+		}
+		taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+		// 同じラウンドで何度公開しても、履歴には一つのラウンドとして残る
+		_, err = client.ShowVotes(ctx, withSession(&pokerv1.ShowVotesRequest{Id: "Taro", RoomId: "history"}, taroToken))
+		if err != nil {
+			t.Fatal(err)
+		}
+		taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SHOW_VOTES)
+	}
+	_, err = client.AcceptEstimate(ctx, withSession(&pokerv1.AcceptEstimateRequest{Id: "Taro", RoomId: "history", Estimate: "5"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STORIES_CHANGED)
+	_, err = client.NewGame(ctx, withSession(&pokerv1.NewGameRequest{Id: "Taro", RoomId: "history"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_NEW_GAME)
+
+	history, err := client.GetRoomHistory(ctx, withSession(&pokerv1.GetRoomHistoryRequest{Id: "Taro", RoomId: "history"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history.Msg.Rounds) != 1 {
+		t.Fatalf("expected 1 round, got %v", history.Msg.Rounds)
+	}
+	round := history.Msg.Rounds[0]
+	if round.StoryId != story.Msg.Story.Id || round.Estimate != "5" || round.Votes[0].Card != "5" {
+		t.Fatalf("unexpected round %v", round)
+	}
+	if round.EndedAt == nil || round.EndedAt.AsTime().Before(round.RevealedAt.AsTime()) {
+		t.Fatalf("round must be ended by the new game: %v", round)
+	}
+
+	_, err = client.GetRoomHistory(ctx, connect.NewRequest(&pokerv1.GetRoomHistoryRequest{Id: "Taro", RoomId: "history"}))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("expected unauthenticated, got %v", err)
+	}
+
+	for _, tc := range []struct {
+		path, contentType, want string
+	}{
+		{"/export/history.json", "application/json", `"storyTitle"`},
+		{"/export/history.csv", "text/csv; charset=utf-8", "Login | SSO,5,"},
+		{"/export/history.md", "text/markdown; charset=utf-8", `| 1 | Login \| SSO | 5 | Taro: 5 | 5 | 5 |  |`},
+	} {
+		req, err := http.NewRequest(http.MethodGet, ts.URL+tc.path+"?id=Taro", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		req.Header.Set(sessionHeader, taroToken)
+		res, err := ts.Client().Do(req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		body, err := io.ReadAll(res.Body)
+		res.Body.Close()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if res.StatusCode != http.StatusOK || res.Header.Get("Content-Type") != tc.contentType {
+			t.Fatalf("%s: unexpected response %d %s", tc.path, res.StatusCode, res.Header.Get("Content-Type"))
+		}
+		if !strings.Contains(string(body), tc.want) {
+			t.Fatalf("%s: %q not found in\n%s", tc.path, tc.want, body)
+		}
+		// protojsonの出力は空白が安定しないので、JSONは読み直して比べる
+		if strings.HasSuffix(tc.path, ".json") {
+			var exported pokerv1.GetRoomHistoryResponse
+			if err := protojson.Unmarshal(body, &exported); err != nil {
+				t.Fatal(err)
+			}
+			if !proto.Equal(&exported, history.Msg) {
+				t.Fatalf("exported history differs from GetRoomHistory:\n%s", body)
+			}
+		}
+	}
+
+	// トークンはURLで渡しても受け付けない
+	for _, token := range []string{"invalid", taroToken} {
+		res, err := ts.Client().Get(ts.URL + "/export/history.csv?id=Taro&session=" + url.QueryEscape(token))
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusUnauthorized {
+			t.Fatalf("expected 401, got %d", res.StatusCode)
+		}
+	}
+}
+
+func TestRoundTimer(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	taroStream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "timer"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(taroStream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	_, err = client.StartTimer(ctx, withSession(&pokerv1.StartTimerRequest{Id: "Taro", RoomId: "timer", DurationSeconds: 0}, taroToken))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+
+	_, err = client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Taro", RoomId: "timer", Card: "8"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+
+	started, err := client.StartTimer(ctx, withSession(&pokerv1.StartTimerRequest{Id: "Taro", RoomId: "timer", DurationSeconds: 2, AutoReveal: true}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if started.Msg.Timer.RemainingSeconds != 2 || !started.Msg.Timer.AutoReveal {
+		t.Fatalf("unexpected timer %v", started.Msg.Timer)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_TIMER_STARTED)
+
+	// カウントダウンの途中で参加したユーザにもタイマーが見える
+	hanakoStream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "timer"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hanako := receive(hanakoStream)
+	status := hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS).GetRoomStatus()
+	if status.Timer == nil || !status.Timer.Deadline.AsTime().Equal(started.Msg.Timer.Deadline.AsTime()) {
+		t.Fatalf("timer must be in the status, got %v", status.Timer)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	tick := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_TIMER_TICK)
+	if tick.GetTimerTick().RemainingSeconds != 1 || tick.Sequence != 0 {
+		t.Fatalf("unexpected tick %v", tick)
+	}
+	expired := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_TIMER_EXPIRED)
+	if !expired.GetTimerExpired().Revealed {
+		t.Fatalf("votes must be revealed automatically: %v", expired)
+	}
+	revealed := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SHOW_VOTES).GetVotesRevealed()
+	if len(revealed.Votes) != 1 || revealed.Votes[0].Card != "8" {
+		t.Fatalf("unexpected votes %v", revealed.Votes)
+	}
+}
+
+// contextStore ネットワーク越しのストアのように、終わったコンテキストでの読み出しを失敗させるRoomStore
+type contextStore struct {
+	RoomStore
+}
+
+func (s contextStore) GetRoom(ctx context.Context, roomId string) (*RoomRecord, error) {
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return s.RoomStore.GetRoom(ctx, roomId)
+}
+
+func TestAutoReveal(t *testing.T) {
+	// 遅延して公開する際に、予約したRPCのコンテキストを使っていれば失敗する
+	ts := newTestServerWithStore(t, contextStore{NewMemoryRoomStore()}, defaultConfig())
+	client := pokerv1connect.NewPlanningPokerServiceClient(ts.Client(), ts.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	taroStream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{
+		Id:       "Taro",
+		RoomId:   "auto",
+		Settings: &pokerv1.RoomSettings{AutoReveal: true},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(taroStream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	tokens := map[string]string{"Taro": taroToken}
+	for _, name := range []string{"Hanako", "Jiro"} {
+		stream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: name, RoomId: "auto"}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := receive(stream)
+		status := r.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS).GetRoomStatus()
+		if !status.Settings.GetAutoReveal() {
+			t.Fatalf("settings must be in the status, got %v", status.Settings)
+		}
+		tokens[name] = stream.ResponseHeader().Get(sessionHeader)
+		taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+	}
+
+	// オブザーバーの投票は待たない
+	_, err = client.SetRole(ctx, withSession(&pokerv1.SetRoleRequest{Id: "Taro", RoomId: "auto", ParticipantId: "Jiro", Role: pokerv1.Role_ROLE_OBSERVER}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_ROLE_CHANGED)
+
+	vote := func(name, card string) {
+		t.Helper()
+		_, err := client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: name, RoomId: "auto", Card: card}, tokens[name]))
+		if err != nil {
+			t.Fatal(err)
+		}
+		taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+	}
+	vote("Taro", "3")
+	vote("Hanako", "5")
+	revealed := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SHOW_VOTES).GetVotesRevealed()
+	if len(revealed.Votes) != 2 {
+		t.Fatalf("unexpected votes %v", revealed.Votes)
+	}
+
+	// 待ち時間を設定すると、最後の投票から待ち時間が経過した後に公開する
+	_, err = client.UpdateSettings(ctx, withSession(&pokerv1.UpdateSettingsRequest{
+		Id:       "Taro",
+		RoomId:   "auto",
+		Settings: &pokerv1.RoomSettings{AutoReveal: true, AutoRevealDelaySeconds: 61},
+	}, taroToken))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+	_, err = client.UpdateSettings(ctx, withSession(&pokerv1.UpdateSettingsRequest{
+		Id:       "Taro",
+		RoomId:   "auto",
+		Settings: &pokerv1.RoomSettings{AutoReveal: true, AutoRevealDelaySeconds: 1},
+	}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SETTINGS_CHANGED)
+
+	// update_maskに含めた設定だけを変え、他の設定は残す
+	_, err = client.UpdateSettings(ctx, withSession(&pokerv1.UpdateSettingsRequest{
+		Id:         "Taro",
+		RoomId:     "auto",
+		Settings:   &pokerv1.RoomSettings{Public: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"unknown"}},
+	}, taroToken))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+	updated, err := client.UpdateSettings(ctx, withSession(&pokerv1.UpdateSettingsRequest{
+		Id:         "Taro",
+		RoomId:     "auto",
+		Settings:   &pokerv1.RoomSettings{Public: true},
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"public"}},
+	}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &pokerv1.RoomSettings{AutoReveal: true, AutoRevealDelaySeconds: 1, Public: true}
+	if !proto.Equal(updated.Msg.Settings, want) {
+		t.Fatalf("expected %v, got %v", want, updated.Msg.Settings)
+	}
+	changed := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SETTINGS_CHANGED).GetSettingsChanged()
+	if !proto.Equal(changed.Settings, want) {
+		t.Fatalf("expected %v, got %v", want, changed.Settings)
+	}
+
+	_, err = client.NewGame(ctx, withSession(&pokerv1.NewGameRequest{Id: "Taro", RoomId: "auto"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_NEW_GAME)
+
+	vote("Taro", "8")
+	vote("Hanako", "8")
+	votedAt := time.Now()
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SHOW_VOTES)
+	if elapsed := time.Since(votedAt); elapsed < 900*time.Millisecond {
+		t.Fatalf("votes must be revealed after the delay, but revealed in %v", elapsed)
+	}
+}
+
+func TestObserver(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	taroStream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{
+		Id:       "Taro",
+		RoomId:   "observer",
+		Settings: &pokerv1.RoomSettings{AutoReveal: true},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(taroStream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	poStream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "PO", RoomId: "observer", Observer: true}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	po := receive(poStream)
+	status := po.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	if status.Message != `{"Taro":false}` {
+		t.Fatalf("observers must not be in the legacy status, got %s", status.Message)
+	}
+	for _, p := range status.GetRoomStatus().Participants {
+		if p.ParticipantId == "PO" && p.Role != pokerv1.Role_ROLE_OBSERVER {
+			t.Fatalf("expected observer, got %v", p.Role)
+		}
+	}
+	poToken := poStream.ResponseHeader().Get(sessionHeader)
+	joined := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN).GetParticipantJoined()
+	if joined.ParticipantId != "PO" || joined.Role != pokerv1.Role_ROLE_OBSERVER {
+		t.Fatalf("unexpected join event %v", joined)
+	}
+
+	_, err = client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "PO", RoomId: "observer", Card: "3"}, poToken))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+
+	// オブザーバーの投票を待たずに公開され、統計にも含まれない
+	_, err = client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Taro", RoomId: "observer", Card: "5"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+	revealed := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SHOW_VOTES).GetVotesRevealed()
+	if len(revealed.Votes) != 1 || revealed.Statistics.Count != 1 {
+		t.Fatalf("unexpected votes %v %v", revealed.Votes, revealed.Statistics)
+	}
+}
+
+func TestLeaveKickAndClose(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	taroStream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "lifecycle"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(taroStream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	receivers := make(map[string]*receiver)
+	tokens := make(map[string]string)
+	for _, name := range []string{"Hanako", "Jiro", "Saburo"} {
+		stream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: name, RoomId: "lifecycle"}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		receivers[name] = receive(stream)
+		receivers[name].expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+		tokens[name] = stream.ResponseHeader().Get(sessionHeader)
+		taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+	}
+
+	// 自分で退出すると、退出のイベントを受け取ってからストリームが終了する
+	_, err = client.LeaveRoom(ctx, withSession(&pokerv1.LeaveRoomRequest{Id: "Hanako", RoomId: "lifecycle"}, tokens["Hanako"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	left := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LEAVE).GetParticipantLeft()
+	if left.ParticipantId != "Hanako" || left.Reason != pokerv1.LeaveReason_LEAVE_REASON_LEFT {
+		t.Fatalf("unexpected leave event %v", left)
+	}
+	hanako := receivers["Hanako"]
+	for range []string{"Hanako", "Jiro", "Saburo"} {
+		hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+	}
+	hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LEAVE)
+	<-hanako.done
+
+	// ファシリテータ以外は外せない
+	_, err = client.KickParticipant(ctx, withSession(&pokerv1.KickParticipantRequest{Id: "Jiro", RoomId: "lifecycle", ParticipantId: "Saburo"}, tokens["Jiro"]))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+
+	_, err = client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Jiro", RoomId: "lifecycle", Card: "3"}, tokens["Jiro"]))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+	_, err = client.KickParticipant(ctx, withSession(&pokerv1.KickParticipantRequest{Id: "Taro", RoomId: "lifecycle", ParticipantId: "Jiro"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	kicked := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LEAVE).GetParticipantLeft()
+	if kicked.ParticipantId != "Jiro" || kicked.Reason != pokerv1.LeaveReason_LEAVE_REASON_KICKED {
+		t.Fatalf("unexpected leave event %v", kicked)
+	}
+	<-receivers["Jiro"].done
+	_, err = client.KickParticipant(ctx, withSession(&pokerv1.KickParticipantRequest{Id: "Taro", RoomId: "lifecycle", ParticipantId: "Jiro"}, taroToken))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	// 外された参加者の投票は残らない
+	shown, err := client.ShowVotes(ctx, withSession(&pokerv1.ShowVotesRequest{Id: "Taro", RoomId: "lifecycle"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(shown.Msg.Votes) != 0 {
+		t.Fatalf("vote of the kicked participant remains: %v", shown.Msg.Votes)
+	}
+
+	_, err = client.CloseRoom(ctx, withSession(&pokerv1.CloseRoomRequest{Id: "Taro", RoomId: "lifecycle"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, r := range []*receiver{taro, receivers["Saburo"]} {
+		for {
+			res := r.next(t)
+			if res.Type == pokerv1.MessageType_MESSAGE_TYPE_ROOM_CLOSED {
+				if res.GetRoomClosed().ClosedBy != "Taro" {
+					t.Fatalf("unexpected close event %v", res)
+				}
+				break
+			}
+		}
+		<-r.done
+	}
+
+	stream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "lifecycle"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stream.Receive() || connect.CodeOf(stream.Err()) != connect.CodeNotFound {
+		t.Fatalf("expected the room to be deleted, got %v", stream.Err())
+	}
+}
+
+func TestRoomDiscovery(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var taroToken string
+	for _, room := range []struct {
+		id     string
+		public bool
+	}{{"alpha", true}, {"beta", true}, {"gamma", false}} {
+		stream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{
+			Id:       "Taro",
+			RoomId:   room.id,
+			Settings: &pokerv1.RoomSettings{Public: room.public},
+		}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		r := receive(stream)
+		r.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+		r.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+		r.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+		if room.id == "alpha" {
+			taroToken = stream.ResponseHeader().Get(sessionHeader)
+		}
+	}
+
+	_, err := client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Taro", RoomId: "alpha", Card: "3"}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := client.GetRoom(ctx, connect.NewRequest(&pokerv1.GetRoomRequest{RoomId: "alpha"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	participants := got.Msg.Room.Participants
+	if len(participants) != 1 || !participants[0].Voted || participants[0].Role != pokerv1.Role_ROLE_FACILITATOR {
+		t.Fatalf("unexpected participants %v", participants)
+	}
+	if !got.Msg.Room.Settings.Public || got.Msg.Room.CreatedAt == nil {
+		t.Fatalf("unexpected room %v", got.Msg.Room)
+	}
+	_, err = client.GetRoom(ctx, connect.NewRequest(&pokerv1.GetRoomRequest{RoomId: "delta"}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	// 公開されたルームだけを、IDの順にページに分けて返す
+	var ids []string
+	var token string
+	for {
+		list, err := client.ListRooms(ctx, connect.NewRequest(&pokerv1.ListRoomsRequest{PageSize: 1, PageToken: token}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, room := range list.Msg.Rooms {
+			ids = append(ids, room.RoomId)
+		}
+		token = list.Msg.NextPageToken
+		if token == "" {
+			break
+		}
+	}
+	if fmt.Sprint(ids) != "[alpha beta]" {
+		t.Fatalf("unexpected rooms %v", ids)
+	}
+
+	list, err := client.ListRooms(ctx, connect.NewRequest(&pokerv1.ListRoomsRequest{Query: "ET"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Msg.Rooms) != 1 || list.Msg.Rooms[0].RoomId != "beta" {
+		t.Fatalf("unexpected rooms %v", list.Msg.Rooms)
+	}
+	_, err = client.ListRooms(ctx, connect.NewRequest(&pokerv1.ListRoomsRequest{PageToken: "!"}))
+	if connect.CodeOf(err) != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", err)
+	}
+}
+
+func TestCreateRoomWithGeneratedID(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	created := receive(stream).expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM).GetRoomCreated()
+	if _, err := uuid.Parse(created.RoomId); err != nil {
+		t.Fatalf("expected a generated room id, got %q", created.RoomId)
+	}
+	if len(created.InviteCode) != inviteCodeLength+1 {
+		t.Fatalf("unexpected invite code %q", created.InviteCode)
+	}
+
+	// 大文字小文字やハイフンの有無、読み間違えやすい文字は区別しない
+	code := strings.ToLower(strings.ReplaceAll(created.InviteCode, "-", ""))
+	code = strings.NewReplacer("0", "o", "1", "l").Replace(code)
+	resolved, err := client.ResolveInviteCode(ctx, connect.NewRequest(&pokerv1.ResolveInviteCodeRequest{InviteCode: code}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resolved.Msg.RoomId != created.RoomId {
+		t.Fatalf("expected %s, got %s", created.RoomId, resolved.Msg.RoomId)
+	}
+	_, err = client.ResolveInviteCode(ctx, connect.NewRequest(&pokerv1.ResolveInviteCodeRequest{InviteCode: "UUUU-UUUU"}))
+	if connect.CodeOf(err) != connect.CodeNotFound {
+		t.Fatalf("expected not found, got %v", err)
+	}
+
+	// 指定したIDが既に使われている場合は、上書きせずにエラーにする
+	first, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "sprint"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	receive(first).expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	second, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Hanako", RoomId: "sprint"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if second.Receive() || connect.CodeOf(second.Err()) != connect.CodeAlreadyExists {
+		t.Fatalf("expected already exists, got %v", second.Err())
+	}
+}
+
+func TestPassphraseAndLock(t *testing.T) {
+	client := newTestClient(t)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	taroStream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "secret", Passphrase: "open sesame"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(taroStream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	// 合言葉が違う、もしくはない場合は参加できない
+	for _, passphrase := range []string{"", "open sesame!"} {
+		stream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "secret", Passphrase: passphrase}))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if stream.Receive() || connect.CodeOf(stream.Err()) != connect.CodeUnauthenticated {
+			t.Fatalf("expected unauthenticated, got %v", stream.Err())
+		}
+	}
+
+	// 参加者としてセッショントークンを示さないと、参加者は見えない
+	for _, tc := range []struct {
+		req     *connect.Request[pokerv1.GetRoomRequest]
+		visible bool
+	}{
+		{connect.NewRequest(&pokerv1.GetRoomRequest{RoomId: "secret"}), false},
+		{withSession(&pokerv1.GetRoomRequest{RoomId: "secret", Id: "Taro"}, "invalid"), false},
+		{withSession(&pokerv1.GetRoomRequest{RoomId: "secret", Id: "Hanako"}, taroToken), false},
+		{withSession(&pokerv1.GetRoomRequest{RoomId: "secret", Id: "Taro"}, taroToken), true},
+	} {
+		got, err := client.GetRoom(ctx, tc.req)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !got.Msg.Room.HasPassphrase || (len(got.Msg.Room.Participants) == 1) != tc.visible {
+			t.Fatalf("unexpected room %v for %v", got.Msg.Room, tc.req.Msg)
+		}
+	}
+
+	hanakoStream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "secret", Passphrase: "open sesame"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hanako := receive(hanakoStream)
+	hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	hanakoToken := hanakoStream.ResponseHeader().Get(sessionHeader)
+	joined := hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	// ファシリテータ以外はロックできない
+	_, err = client.SetRoomLock(ctx, withSession(&pokerv1.SetRoomLockRequest{Id: "Hanako", RoomId: "secret", Locked: true}, hanakoToken))
+	if connect.CodeOf(err) != connect.CodePermissionDenied {
+		t.Fatalf("expected permission denied, got %v", err)
+	}
+	_, err = client.SetRoomLock(ctx, withSession(&pokerv1.SetRoomLockRequest{Id: "Taro", RoomId: "secret", Locked: true}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	changed := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LOCK_CHANGED).GetRoomLockChanged()
+	if !changed.Locked || changed.ChangedBy != "Taro" {
+		t.Fatalf("unexpected lock event %v", changed)
+	}
+
+	// ロック中は正しい合言葉でも新しく参加できないが、既存の参加者は再接続できる
+	stream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Jiro", RoomId: "secret", Passphrase: "open sesame"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stream.Receive() || connect.CodeOf(stream.Err()) != connect.CodeFailedPrecondition {
+		t.Fatalf("expected failed precondition, got %v", stream.Err())
+	}
+	resumed, err := client.Connect(ctx, withSession(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "secret", ResumeAfter: joined.Sequence}, hanakoToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	receive(resumed).expect(t, pokerv1.MessageType_MESSAGE_TYPE_LOCK_CHANGED)
+
+	_, err = client.SetRoomLock(ctx, withSession(&pokerv1.SetRoomLockRequest{Id: "Taro", RoomId: "secret", Locked: false}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	stream, err = client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Jiro", RoomId: "secret", Passphrase: "open sesame"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	receive(stream).expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+
+	stream, err = client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "long", Passphrase: strings.Repeat("a", maxPassphraseLength+1)}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stream.Receive() || connect.CodeOf(stream.Err()) != connect.CodeInvalidArgument {
+		t.Fatalf("expected invalid argument, got %v", stream.Err())
+	}
+}
+
+func TestMetrics(t *testing.T) {
+	ts := newTestServer(t)
+	client := pokerv1connect.NewPlanningPokerServiceClient(ts.Client(), ts.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "metrics"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(stream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	token := stream.ResponseHeader().Get(sessionHeader)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	_, err = client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Taro", RoomId: "metrics", Card: "3"}, token))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.ShowVotes(ctx, withSession(&pokerv1.ShowVotesRequest{Id: "Taro", RoomId: "metrics"}, token))
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = client.ShowVotes(ctx, withSession(&pokerv1.ShowVotesRequest{Id: "Taro", RoomId: "metrics"}, "wrong"))
+	if connect.CodeOf(err) != connect.CodeUnauthenticated {
+		t.Fatalf("expected unauthenticated, got %v", err)
+	}
+
+	res, err := ts.Client().Get(ts.URL + metricsPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer res.Body.Close()
+	body, err := io.ReadAll(res.Body)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		"planning_poker_active_rooms 1",
+		"planning_poker_connected_participants 1",
+		"planning_poker_votes_cast_total 1",
+		"planning_poker_reveals_total 1",
+		`planning_poker_rpcs_total{code="ok",procedure="/proto.v1.PlanningPokerService/ShowVotes"} 1`,
+		`planning_poker_rpcs_total{code="unauthenticated",procedure="/proto.v1.PlanningPokerService/ShowVotes"} 1`,
+		`planning_poker_rpc_duration_seconds_count{code="ok",procedure="/proto.v1.PlanningPokerService/Vote"} 1`,
+		`planning_poker_broadcast_failures_total{reason="slow_consumer"} 0`,
+		"planning_poker_rooms_reaped_total 0",
+	} {
+		if !strings.Contains(string(body), want) {
+			t.Errorf("metrics do not contain %q", want)
+		}
+	}
+}
+
+func TestRoomLimits(t *testing.T) {
+	cfg := defaultConfig()
+	cfg.MaxRooms = 1
+	cfg.MaxParticipants = 2
+	ts := newTestServerWithConfig(t, cfg)
+	client := pokerv1connect.NewPlanningPokerServiceClient(ts.Client(), ts.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	stream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "limited"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	receive(stream).expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+
+	other, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Jiro", RoomId: "other"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if other.Receive() || connect.CodeOf(other.Err()) != connect.CodeResourceExhausted {
+		t.Fatalf("expected resource exhausted, got %v", other.Err())
+	}
+
+	hanako, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "limited"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	receive(hanako).expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+
+	saburo, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Saburo", RoomId: "limited"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saburo.Receive() || connect.CodeOf(saburo.Err()) != connect.CodeResourceExhausted {
+		t.Fatalf("expected resource exhausted, got %v", saburo.Err())
+	}
+}
+
+func TestGracefulShutdown(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "rooms.db")
+	start := func(store RoomStore) (*pokerServer, *httptest.Server, pokerv1connect.PlanningPokerServiceClient) {
+		server := newPokerServer(store, NewLocalBus(), defaultConfig())
+		ts := httptest.NewUnstartedServer(newServeMux(server))
+		ts.EnableHTTP2 = true
+		ts.StartTLS()
+		t.Cleanup(ts.Close)
+		return server, ts, pokerv1connect.NewPlanningPokerServiceClient(ts.Client(), ts.URL)
+	}
+	store1, err := NewFileRoomStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server1, ts1, client := start(store1)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	taroStream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "durable"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(taroStream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	hanakoStream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "durable"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hanako := receive(hanakoStream)
+	hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	hanakoToken := hanakoStream.ResponseHeader().Get(sessionHeader)
+	hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	_, err = client.SetRoomLock(ctx, withSession(&pokerv1.SetRoomLockRequest{Id: "Taro", RoomId: "durable", Locked: true}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LOCK_CHANGED)
+	locked := hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LOCK_CHANGED)
+
+	// 停止を通知されたストリームは、エラーなく終了する
+	server1.drain(ctx)
+	for _, r := range []*receiver{taro, hanako} {
+		r.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SERVER_SHUTTING_DOWN)
+		<-r.done
+	}
+	if taroStream.Err() != nil || hanakoStream.Err() != nil {
+		t.Fatalf("streams must end without errors, got %v and %v", taroStream.Err(), hanakoStream.Err())
+	}
+
+	// 停止中は新しいストリームを受け付けない
+	stream, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Jiro", RoomId: "durable"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stream.Receive() || connect.CodeOf(stream.Err()) != connect.CodeUnavailable {
+		t.Fatalf("expected unavailable, got %v", stream.Err())
+	}
+	stream, err = client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Jiro", RoomId: "another"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stream.Receive() || connect.CodeOf(stream.Err()) != connect.CodeUnavailable {
+		t.Fatalf("expected unavailable, got %v", stream.Err())
+	}
+
+	shutdownCtx, cancelShutdown := context.WithTimeout(ctx, 5*time.Second)
+	defer cancelShutdown()
+	if err := server1.shutdown(shutdownCtx, ts1.Config); err != nil {
+		t.Fatal(err)
+	}
+	if err := store1.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	// 再起動後は、停止前のトークンで見逃したイベントから再開でき、シーケンス番号も続く
+	store2, err := NewFileRoomStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store2.Close()
+	_, _, client = start(store2)
+
+	resumed, err := client.Connect(ctx, withSession(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "durable", ResumeAfter: locked.Sequence}, hanakoToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hanako = receive(resumed)
+	shuttingDown := hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SERVER_SHUTTING_DOWN)
+	if shuttingDown.Sequence != locked.Sequence+1 {
+		t.Fatalf("unexpected sequence %d", shuttingDown.Sequence)
+	}
+	rejoined := hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+	if rejoined.Sequence != shuttingDown.Sequence+1 {
+		t.Fatalf("unexpected sequence %d", rejoined.Sequence)
+	}
+
+	// 他の参加者のトークンでは再開できず、ロックされたルームには参加できない
+	stream, err = client.Connect(ctx, withSession(&pokerv1.ConnectRequest{Id: "Taro", RoomId: "durable", ResumeAfter: locked.Sequence}, hanakoToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stream.Receive() || connect.CodeOf(stream.Err()) != connect.CodeFailedPrecondition {
+		t.Fatalf("expected failed precondition, got %v", stream.Err())
+	}
+
+	resumed, err = client.Connect(ctx, withSession(&pokerv1.ConnectRequest{Id: "Taro", RoomId: "durable", ResumeAfter: rejoined.Sequence}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro = receive(resumed)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+	hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+	taroToken = resumed.ResponseHeader().Get(sessionHeader)
+
+	// ロールも引き継がれている
+	_, err = client.SetRoomLock(ctx, withSession(&pokerv1.SetRoomLockRequest{Id: "Taro", RoomId: "durable", Locked: false}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LOCK_CHANGED)
+}
+
+// unavailableStore Pingが失敗するかどうかを切り替えられるRoomStore
+type unavailableStore struct {
+	RoomStore
+	unavailable atomic.Bool
+}
+
+func (s *unavailableStore) Ping(ctx context.Context) error {
+	if s.unavailable.Load() {
+		return errors.New("store is unavailable")
+	}
+	return s.RoomStore.Ping(ctx)
+}
+
+func TestHealth(t *testing.T) {
+	store := &unavailableStore{RoomStore: NewMemoryRoomStore()}
+	server := newPokerServer(store, NewLocalBus(), defaultConfig())
+	ts := httptest.NewUnstartedServer(newServeMux(server))
+	ts.EnableHTTP2 = true
+	ts.StartTLS()
+	t.Cleanup(ts.Close)
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	// check Connectのプロトコルで、JSONのままCheckを呼び出してHTTPのステータスと状態を返す
+	check := func(service string) (int, string) {
+		t.Helper()
+		body := fmt.Sprintf(`{"service":%q}`, service)
+		res, err := ts.Client().Post(ts.URL+"/"+grpchealth.HealthV1ServiceName+"/Check", "application/json", strings.NewReader(body))
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer res.Body.Close()
+		var msg struct {
+			Status string `json:"status"`
+		}
+		if err := json.NewDecoder(res.Body).Decode(&msg); err != nil {
+			t.Fatal(err)
+		}
+		return res.StatusCode, msg.Status
+	}
+
+	// expectStatus livenessPathは常に200で、readinessPathとCheckはreadyに応じた状態を返す
+	expectStatus := func(ready bool) {
+		t.Helper()
+		res, err := ts.Client().Get(ts.URL + livenessPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != http.StatusOK {
+			t.Fatalf("unexpected liveness status %d", res.StatusCode)
+		}
+
+		// grpchealthのprotoでは、JSONの列挙子の名前に接頭辞が付く
+		wantCode, wantStatus := http.StatusOK, "SERVING_STATUS_SERVING"
+		if !ready {
+			wantCode, wantStatus = http.StatusServiceUnavailable, "SERVING_STATUS_NOT_SERVING"
+		}
+		res, err = ts.Client().Get(ts.URL + readinessPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		res.Body.Close()
+		if res.StatusCode != wantCode {
+			t.Fatalf("expected readiness status %d, got %d", wantCode, res.StatusCode)
+		}
+		for _, service := range []string{"", pokerv1connect.PlanningPokerServiceName} {
+			code, status := check(service)
+			if code != http.StatusOK || status != wantStatus {
+				t.Fatalf("expected %v for %q, got %d %v", wantStatus, service, code, status)
+			}
+		}
+	}
+
+	expectStatus(true)
+	if code, _ := check("unknown.v1.Service"); code != http.StatusNotFound {
+		t.Fatalf("expected not found, got %d", code)
+	}
+
+	// ストアを使えなくなったら、受け付けられない状態になる
+	store.unavailable.Store(true)
+	expectStatus(false)
+	store.unavailable.Store(false)
+	expectStatus(true)
+
+	// 停止中も受け付けられない状態になる
+	server.drain(ctx)
+	expectStatus(false)
+
+	reflection := grpcreflect.NewClient(ts.Client(), ts.URL).NewStream(ctx)
+	defer reflection.Close()
+	services, err := reflection.ListServices()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []protoreflect.FullName{pokerv1connect.PlanningPokerServiceName, grpchealth.HealthV1ServiceName} {
+		if !slices.Contains(services, want) {
+			t.Fatalf("%s is not listed in %v", want, services)
+		}
+	}
+	if _, err := reflection.FileContainingSymbol(pokerv1connect.PlanningPokerServiceName); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestMultiInstance(t *testing.T) {
+	// それぞれ、インスタンスごとのストアを返す関数と、インスタンスの間で共有するバスを返す
+	backends := map[string]func(t *testing.T) (func() RoomStore, Bus){
+		"local": func(t *testing.T) (func() RoomStore, Bus) {
+			store := NewMemoryRoomStore()
+			return func() RoomStore { return store }, NewLocalBus()
+		},
+		"redis": func(t *testing.T) (func() RoomStore, Bus) {
+			url := "redis://" + miniredis.RunT(t).Addr()
+			bus, err := NewRedisBus(url)
+			if err != nil {
+				t.Fatal(err)
+			}
+			t.Cleanup(func() { bus.Close() })
+			// 別々のプロセスと同じように、インスタンスごとにサーバに接続する
+			return func() RoomStore {
+				store, err := NewRedisRoomStore(url)
+				if err != nil {
+					t.Fatal(err)
+				}
+				t.Cleanup(func() { store.Close() })
+				return store
+			}, bus
+		},
+	}
+	for name, newBackend := range backends {
+		t.Run(name, func(t *testing.T) {
+			// 同じルームの状態とバスを共有するインスタンスを起動する
+			newStore, bus := newBackend(t)
+			start := func() (*pokerServer, pokerv1connect.PlanningPokerServiceClient) {
+				server := newPokerServer(newStore(), bus, defaultConfig())
+				ts := httptest.NewUnstartedServer(newServeMux(server))
+				ts.EnableHTTP2 = true
+				ts.StartTLS()
+				t.Cleanup(ts.Close)
+				return server, pokerv1connect.NewPlanningPokerServiceClient(ts.Client(), ts.URL)
+			}
+			_, clientA := start()
+			_, clientB := start()
+			serverC, clientC := start()
+			ctx, cancel := context.WithCancel(context.Background())
+			defer cancel()
+
+			taroStream, err := clientA.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "shared"}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			taro := receive(taroStream)
+			taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+			taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+			taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+			taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+			// 別のインスタンスに接続しても、同じルームの参加者が見える
+			hanakoStream, err := clientB.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "shared"}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			hanako := receive(hanakoStream)
+			status := hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS).GetRoomStatus()
+			var names []string
+			for _, p := range status.Participants {
+				names = append(names, p.ParticipantId)
+			}
+			if !slices.Equal(names, []string{"Hanako", "Taro"}) {
+				t.Fatalf("unexpected participants %v", names)
+			}
+			hanakoToken := hanakoStream.ResponseHeader().Get(sessionHeader)
+			hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+			if joined := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN).GetParticipantJoined(); joined.ParticipantId != "Hanako" {
+				t.Fatalf("unexpected join event %v", joined)
+			}
+
+			// 他のインスタンスに接続している名前では参加できない
+			duplicate, err := clientB.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Taro", RoomId: "shared"}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if duplicate.Receive() || connect.CodeOf(duplicate.Err()) != connect.CodeAlreadyExists {
+				t.Fatalf("expected already exists, got %v", duplicate.Err())
+			}
+
+			// 投票はどちらのインスタンスの参加者にも届く
+			_, err = clientB.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Hanako", RoomId: "shared", Card: "5"}, hanakoToken))
+			if err != nil {
+				t.Fatal(err)
+			}
+			hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+			taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+			_, err = clientA.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Taro", RoomId: "shared", Card: "3"}, taroToken))
+			if err != nil {
+				t.Fatal(err)
+			}
+			taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+			hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+
+			// 他のインスタンスで発行されたセッションでも、ストリームを受け持たないインスタンスに送ったリクエストが認証される
+			_, err = clientA.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Hanako", RoomId: "shared", Card: "8"}, hanakoToken))
+			if err != nil {
+				t.Fatal(err)
+			}
+			taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+			hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+			_, err = clientC.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Hanako", RoomId: "shared", Card: "8"}, taroToken))
+			if connect.CodeOf(err) != connect.CodeUnauthenticated {
+				t.Fatalf("expected unauthenticated, got %v", err)
+			}
+
+			shown, err := clientA.ShowVotes(ctx, withSession(&pokerv1.ShowVotesRequest{Id: "Taro", RoomId: "shared"}, taroToken))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(shown.Msg.Votes) != 2 {
+				t.Fatalf("expected votes of both instances, got %v", shown.Msg.Votes)
+			}
+			for _, v := range shown.Msg.Votes {
+				if v.ParticipantId == "Hanako" && v.Card != "8" {
+					t.Fatalf("unexpected vote %v", v)
+				}
+			}
+			taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SHOW_VOTES)
+			hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SHOW_VOTES)
+
+			// 他のインスタンスの参加者も外せる
+			_, err = clientA.KickParticipant(ctx, withSession(&pokerv1.KickParticipantRequest{Id: "Taro", RoomId: "shared", ParticipantId: "Hanako"}, taroToken))
+			if err != nil {
+				t.Fatal(err)
+			}
+			taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LEAVE)
+			<-hanako.done
+
+			// 停止するインスタンスの参加者は、他のインスタンスに接続し直せる
+			jiroStream, err := clientC.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Jiro", RoomId: "shared"}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			jiro := receive(jiroStream)
+			jiro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+			jiroToken := jiroStream.ResponseHeader().Get(sessionHeader)
+			jiro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+			taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+			_, err = clientA.SetRole(ctx, withSession(&pokerv1.SetRoleRequest{Id: "Taro", RoomId: "shared", ParticipantId: "Jiro", Role: pokerv1.Role_ROLE_FACILITATOR}, taroToken))
+			if err != nil {
+				t.Fatal(err)
+			}
+			taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_ROLE_CHANGED)
+			promoted := jiro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_ROLE_CHANGED)
+			_, err = clientA.SetRoomLock(ctx, withSession(&pokerv1.SetRoomLockRequest{Id: "Taro", RoomId: "shared", Locked: true}, taroToken))
+			if err != nil {
+				t.Fatal(err)
+			}
+			taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LOCK_CHANGED)
+			jiro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LOCK_CHANGED)
+			serverC.drain(ctx)
+			jiro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SERVER_SHUTTING_DOWN)
+			<-jiro.done
+			if left := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LEAVE).GetParticipantLeft(); left.ParticipantId != "Jiro" {
+				t.Fatalf("unexpected leave event %v", left)
+			}
+			serverC.persist(ctx)
+
+			// 既にルームを管理しているインスタンスでも、停止前のトークンで再開すれば、ロックされていても参加でき、ロールも引き継がれる
+			// 見逃したイベントは、停止したインスタンスが保存したイベントログから再送される
+			jiroStream, err = clientA.Connect(ctx, withSession(&pokerv1.ConnectRequest{Id: "Jiro", RoomId: "shared", ResumeAfter: promoted.Sequence}, jiroToken))
+			if err != nil {
+				t.Fatal(err)
+			}
+			jiro = receive(jiroStream)
+			jiro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LOCK_CHANGED)
+			jiro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SERVER_SHUTTING_DOWN)
+			if joined := jiro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN).GetParticipantJoined(); joined.Role != pokerv1.Role_ROLE_FACILITATOR {
+				t.Fatalf("role must be kept, got %v", joined)
+			}
+			taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+			jiroToken = jiroStream.ResponseHeader().Get(sessionHeader)
+			_, err = clientB.SetRoomLock(ctx, withSession(&pokerv1.SetRoomLockRequest{Id: "Jiro", RoomId: "shared", Locked: false}, jiroToken))
+			if err != nil {
+				t.Fatal(err)
+			}
+			taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LOCK_CHANGED)
+			jiro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LOCK_CHANGED)
+
+			// 他のインスタンスに接続しているストリームも、ルームを閉じると終了する
+			hanakoStream, err = clientB.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "shared"}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			hanako = receive(hanakoStream)
+			hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+			hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+			taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+			jiro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+			_, err = clientA.CloseRoom(ctx, withSession(&pokerv1.CloseRoomRequest{Id: "Taro", RoomId: "shared"}, taroToken))
+			if err != nil {
+				t.Fatal(err)
+			}
+			hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_ROOM_CLOSED)
+			<-hanako.done
+			<-jiro.done
+			<-taro.done
+		})
+	}
+}
+
+func TestCrashedInstance(t *testing.T) {
+	store := NewMemoryRoomStore()
+	server := newPokerServer(store, NewLocalBus(), defaultConfig())
+	server.rooms.sessionTTL = 300 * time.Millisecond
+	ts := httptest.NewUnstartedServer(newServeMux(server))
+	ts.EnableHTTP2 = true
+	ts.StartTLS()
+	t.Cleanup(ts.Close)
+	client := pokerv1connect.NewPlanningPokerServiceClient(ts.Client(), ts.URL)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	taroStream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{
+		Id:       "Taro",
+		RoomId:   "crash",
+		Settings: &pokerv1.RoomSettings{AutoReveal: true},
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(taroStream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	// 退出させずに落ちたインスタンスが受け持っていた参加者は、期限を延ばされないままストアに残る
+	ghost := Session{TokenHash: hashToken("ghost"), Instance: "crashed", ExpiresAt: time.Now().Add(time.Second)}
+	if err := store.AddParticipant(ctx, "crash", "Ghost", ghost); err != nil {
+		t.Fatal(err)
+	}
+	jiro := Session{TokenHash: hashToken("jiro"), Instance: "crashed", ExpiresAt: time.Now().Add(time.Minute)}
+	if err := store.AddParticipant(ctx, "crash", "Jiro", jiro); err != nil {
+		t.Fatal(err)
+	}
+
+	// 期限が切れるまでは、同じ名前で新しく参加できない
+	dup, err := client.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Ghost", RoomId: "crash"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if dup.Receive() || connect.CodeOf(dup.Err()) != connect.CodeAlreadyExists {
+		t.Fatalf("expected already exists, got %v", dup.Err())
+	}
+
+	// 落ちたインスタンスで発行されたトークンがあれば、期限を待たずに接続し直せる
+	jiroStream, err := client.Connect(ctx, withSession(&pokerv1.ConnectRequest{Id: "Jiro", RoomId: "crash", ResumeAfter: 1}, "jiro"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	resumed := receive(jiroStream)
+	resumed.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	jiroToken := jiroStream.ResponseHeader().Get(sessionHeader)
+
+	for name, token := range map[string]string{"Taro": taroToken, "Jiro": jiroToken} {
+		_, err := client.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: name, RoomId: "crash", Card: "3"}, token))
+		if err != nil {
+			t.Fatal(err)
+		}
+		// 移ってきた参加者の参加は通知されない
+		taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+	}
+
+	// 期限が切れた参加者は外され、残った全員が投票していれば公開される
+	if left := taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_LEAVE).GetParticipantLeft(); left.ParticipantId != "Ghost" || left.Reason != pokerv1.LeaveReason_LEAVE_REASON_DISCONNECTED {
+		t.Fatalf("unexpected leave event %v", left)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_SHOW_VOTES)
+
+	// 残った参加者が全員退出すれば、ルームは閉じられる
+	for name, token := range map[string]string{"Taro": taroToken, "Jiro": jiroToken} {
+		_, err := client.LeaveRoom(ctx, withSession(&pokerv1.LeaveRoomRequest{Id: name, RoomId: "crash"}, token))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if _, err := store.GetRoom(ctx, "crash"); !errors.Is(err, ErrRoomNotFound) {
+		t.Fatalf("expected the room to be closed, got %v", err)
+	}
+}
+
+func TestSharedRoundTimer(t *testing.T) {
+	store := NewMemoryRoomStore()
+	bus := NewLocalBus()
+	start := func() (*pokerServer, pokerv1connect.PlanningPokerServiceClient) {
+		server := newPokerServer(store, bus, defaultConfig())
+		ts := httptest.NewUnstartedServer(newServeMux(server))
+		ts.EnableHTTP2 = true
+		ts.StartTLS()
+		t.Cleanup(ts.Close)
+		return server, pokerv1connect.NewPlanningPokerServiceClient(ts.Client(), ts.URL)
+	}
+	serverA, clientA := start()
+	_, clientB := start()
+	_, clientC := start()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// 残り時間の通知は、いつ届くかがタイミングによるので読み飛ばす
+	expect := func(r *receiver, mt pokerv1.MessageType) *pokerv1.ConnectResponse {
+		t.Helper()
+		for {
+			res := r.next(t)
+			if res.Type == pokerv1.MessageType_MESSAGE_TYPE_TIMER_TICK {
+				continue
+			}
+			if res.Type != mt {
+				t.Fatalf("expected %v, got %v (%v)", mt, res.Type, res.Event)
+			}
+			return res
+		}
+	}
+
+	taroStream, err := clientA.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: "timer"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro := receive(taroStream)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+	taroToken := taroStream.ResponseHeader().Get(sessionHeader)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	hanakoStream, err := clientB.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Hanako", RoomId: "timer"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hanako := receive(hanakoStream)
+	hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS)
+	hanakoToken := hanakoStream.ResponseHeader().Get(sessionHeader)
+	hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	_, err = clientB.Vote(ctx, withSession(&pokerv1.VoteRequest{Id: "Hanako", RoomId: "timer", Card: "5"}, hanakoToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_VOTE)
+
+	started, err := clientA.StartTimer(ctx, withSession(&pokerv1.StartTimerRequest{Id: "Taro", RoomId: "timer", DurationSeconds: 2, AutoReveal: true}, taroToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	taro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_TIMER_STARTED)
+	hanako.expect(t, pokerv1.MessageType_MESSAGE_TYPE_TIMER_STARTED)
+
+	// タイマーを開始していないインスタンスに参加しても、タイマーが見える
+	jiroStream, err := clientC.Connect(ctx, connect.NewRequest(&pokerv1.ConnectRequest{Id: "Jiro", RoomId: "timer"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	jiro := receive(jiroStream)
+	status := jiro.expect(t, pokerv1.MessageType_MESSAGE_TYPE_STATUS).GetRoomStatus()
+	if status.Timer == nil || !status.Timer.Deadline.AsTime().Equal(started.Msg.Timer.Deadline.AsTime()) {
+		t.Fatalf("timer must be in the status, got %v", status.Timer)
+	}
+	expect(jiro, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+	expect(hanako, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+	expect(taro, pokerv1.MessageType_MESSAGE_TYPE_JOIN)
+
+	// タイマーを開始したインスタンスが止まっても、他のインスタンスが期限切れを処理して公開する
+	serverA.drain(ctx)
+	expect(taro, pokerv1.MessageType_MESSAGE_TYPE_SERVER_SHUTTING_DOWN)
+	<-taro.done
+	for _, r := range []*receiver{hanako, jiro} {
+		expect(r, pokerv1.MessageType_MESSAGE_TYPE_LEAVE)
+		expect(r, pokerv1.MessageType_MESSAGE_TYPE_ROLE_CHANGED)
+		if expired := expect(r, pokerv1.MessageType_MESSAGE_TYPE_TIMER_EXPIRED); !expired.GetTimerExpired().Revealed {
+			t.Fatalf("votes must be revealed automatically: %v", expired)
+		}
+		expect(r, pokerv1.MessageType_MESSAGE_TYPE_SHOW_VOTES)
+	}
+
+	// 期限切れを処理するのは一つのインスタンスだけなので、公開は一度だけ記録される
+	history, err := clientB.GetRoomHistory(ctx, withSession(&pokerv1.GetRoomHistoryRequest{Id: "Hanako", RoomId: "timer"}, hanakoToken))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history.Msg.Rounds) != 1 {
+		t.Fatalf("expected a single round, got %v", history.Msg.Rounds)
+	}
+	if r, _ := store.GetRoom(ctx, "timer"); r.Timer != nil {
+		t.Fatalf("expired timer must be removed, got %v", r.Timer)
+	}
+}
@@ -0,0 +1,140 @@
+package main
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// metricsPath Prometheusの形式で指標を公開するパス
+const metricsPath = "/metrics"
+
+// 配信に失敗した理由
+const (
+	failureSendError    = "send_error"
+	failureSlowConsumer = "slow_consumer"
+)
+
+// serverMetrics Prometheusの形式で公開するサーバの指標。
+// テストで複数のサーバを立てられるように、グローバルではなくサーバごとのレジストリに登録する。
+type serverMetrics struct {
+	registry              *prometheus.Registry
+	connectedParticipants prometheus.Gauge
+	votesCast             prometheus.Counter
+	reveals               prometheus.Counter
+	rpcs                  *prometheus.CounterVec
+	rpcDuration           *prometheus.HistogramVec
+	broadcastFailures     *prometheus.CounterVec
+	roomsReaped           prometheus.Counter
+}
+
+func newServerMetrics() *serverMetrics {
+	m := &serverMetrics{
+		registry: prometheus.NewRegistry(),
+		connectedParticipants: prometheus.NewGauge(prometheus.GaugeOpts{
+			Name: "planning_poker_connected_participants",
+			Help: "Number of open streams of participants connected to rooms.",
+		}),
+		votesCast: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "planning_poker_votes_cast_total",
+			Help: "Number of votes cast.",
+		}),
+		reveals: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "planning_poker_reveals_total",
+			Help: "Number of rounds whose votes were revealed.",
+		}),
+		rpcs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "planning_poker_rpcs_total",
+			Help: "Number of RPCs handled, by procedure and code.",
+		}, []string{"procedure", "code"}),
+		rpcDuration: prometheus.NewHistogramVec(prometheus.HistogramOpts{
+			Name:    "planning_poker_rpc_duration_seconds",
+			Help:    "Time taken to handle RPCs, by procedure and code. Streams are observed when they end.",
+			Buckets: prometheus.DefBuckets,
+		}, []string{"procedure", "code"}),
+		broadcastFailures: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Name: "planning_poker_broadcast_failures_total",
+			Help: "Number of events that could not be delivered to a participant, by reason.",
+		}, []string{"reason"}),
+		roomsReaped: prometheus.NewCounter(prometheus.CounterOpts{
+			Name: "planning_poker_rooms_reaped_total",
+			Help: "Number of rooms closed by the idle sweeper.",
+		}),
+	}
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.connectedParticipants,
+		m.votesCast,
+		m.reveals,
+		m.rpcs,
+		m.rpcDuration,
+		m.broadcastFailures,
+		m.roomsReaped,
+	)
+	// 0件でも系列が見えるように、理由ごとに初期化しておく
+	for _, reason := range []string{failureSendError, failureSlowConsumer} {
+		m.broadcastFailures.WithLabelValues(reason)
+	}
+	return m
+}
+
+// watchRooms 接続の管理をしているルームの数を、取得のたびにroomsから数える
+func (m *serverMetrics) watchRooms(rooms *RoomMap) {
+	m.registry.MustRegister(prometheus.NewGaugeFunc(prometheus.GaugeOpts{
+		Name: "planning_poker_active_rooms",
+		Help: "Number of rooms with a running room goroutine.",
+	}, func() float64 {
+		return float64(rooms.len())
+	}))
+}
+
+// handler 指標を返すHTTPハンドラ
+func (m *serverMetrics) handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+// observe RPCの結果を記録する
+func (m *serverMetrics) observe(procedure string, start time.Time, err error) {
+	code := "ok"
+	if err != nil {
+		code = connect.CodeOf(err).String()
+	}
+	m.rpcs.WithLabelValues(procedure, code).Inc()
+	m.rpcDuration.WithLabelValues(procedure, code).Observe(time.Since(start).Seconds())
+}
+
+// metricsInterceptor RPCの回数と処理時間を、プロシージャとコードごとに記録する
+type metricsInterceptor struct {
+	metrics *serverMetrics
+}
+
+func (i *metricsInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if req.Spec().IsClient {
+			return next(ctx, req)
+		}
+		start := time.Now()
+		res, err := next(ctx, req)
+		i.metrics.observe(req.Spec().Procedure, start, err)
+		return res, err
+	}
+}
+
+func (i *metricsInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *metricsInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		start := time.Now()
+		err := next(ctx, conn)
+		i.metrics.observe(conn.Spec().Procedure, start, err)
+		return err
+	}
+}
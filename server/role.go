@@ -0,0 +1,232 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"connectrpc.com/connect"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+var (
+	ErrNotConnected       = errors.New("you are not connected to this room")
+	ErrNotFacilitator     = errors.New("only the facilitator can do this")
+	ErrNoFacilitator      = errors.New("the room must have at least one facilitator")
+	ErrObserverCannotVote = errors.New("observers cannot vote")
+)
+
+// requireParticipant 他のインスタンスも含めてparticipantがルームに接続していることを確認し、ルームの状態を返す。
+// 参加者として操作するRPCはこれを通してから処理する。
+func (st *roomState) requireParticipant(ctx context.Context, participant string) (*RoomRecord, error) {
+	record, err := st.store.GetRoom(ctx, st.id)
+	if err != nil {
+		return nil, roomNotFoundOr(st.id, err)
+	}
+	if !record.hasParticipant(participant) {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			ErrNotConnected,
+		)
+	}
+	return record, nil
+}
+
+// authorizeFacilitator participantがルームに接続しているファシリテータであることを確認する。
+// ShowVotesやNewGameなど、ルームを操作するRPCはこれを通してから処理する。
+func (st *roomState) authorizeFacilitator(ctx context.Context, participant string) (*RoomRecord, error) {
+	record, err := st.requireParticipant(ctx, participant)
+	if err != nil {
+		return nil, err
+	}
+	if record.roleOf(participant) != pokerv1.Role_ROLE_FACILITATOR {
+		return nil, connect.NewError(
+			connect.CodePermissionDenied,
+			ErrNotFacilitator,
+		)
+	}
+	return record, nil
+}
+
+// connectedFacilitators 他のインスタンスに接続している参加者も含めて、接続中のファシリテータの数を返す
+func (st *roomState) connectedFacilitators(record *RoomRecord) int {
+	var n int
+	for _, name := range record.Participants {
+		if record.roleOf(name) == pokerv1.Role_ROLE_FACILITATOR {
+			n++
+		}
+	}
+	return n
+}
+
+// ensureFacilitator 接続中のファシリテータがいなくなった場合に、最も早く参加した参加者をファシリテータにする。
+// オブザーバーは、他に候補がいない場合にだけファシリテータにする。
+func (st *roomState) ensureFacilitator(ctx context.Context) {
+	record, err := st.store.GetRoom(ctx, st.id)
+	if err != nil {
+		st.logger(ctx).Error("failed to get room", "error", err)
+		return
+	}
+	if st.connectedFacilitators(record) > 0 {
+		return
+	}
+
+	var candidate string
+	for _, name := range record.Participants {
+		if record.roleOf(name) != pokerv1.Role_ROLE_OBSERVER {
+			candidate = name
+			break
+		}
+		if candidate == "" {
+			candidate = name
+		}
+	}
+	if candidate == "" {
+		return
+	}
+
+	if err := st.store.SetRole(ctx, st.id, candidate, pokerv1.Role_ROLE_FACILITATOR); err != nil {
+		st.logger(ctx).Error("failed to set role", "error", err)
+		return
+	}
+	st.logger(ctx).Info("facilitator changed", "facilitator", candidate)
+	st.broadcast(newRoleChangedEvent(candidate, pokerv1.Role_ROLE_FACILITATOR))
+}
+
+// applyObserver 参加する際に指定されたモードに合わせて、participantのロールを変える。
+// オブザーバーになる場合は、以前の投票を消して統計に含まれないようにする。
+// ロールは参加のイベントで伝わるので、ここでは通知しない。
+func (st *roomState) applyObserver(ctx context.Context, participant string, observer bool) {
+	record, err := st.store.GetRoom(ctx, st.id)
+	if err != nil {
+		st.logger(ctx).Error("failed to get room", "error", err)
+		return
+	}
+	current := record.roleOf(participant)
+	role := current
+	switch {
+	case observer:
+		role = pokerv1.Role_ROLE_OBSERVER
+	case current == pokerv1.Role_ROLE_OBSERVER:
+		role = pokerv1.Role_ROLE_VOTER
+	}
+	if role != current {
+		if err := st.store.SetRole(ctx, st.id, participant, role); err != nil {
+			st.logger(ctx).Error("failed to set role", "error", err)
+			return
+		}
+	}
+	if _, voted := record.Votes[participant]; observer && voted {
+		if err := st.store.ClearVote(ctx, st.id, participant); err != nil {
+			st.logger(ctx).Error("failed to clear vote", "error", err)
+		}
+	}
+}
+
+// requireConnected 他のインスタンスも含めてparticipantが接続中でなければFailedPreconditionのエラーを返す
+func (st *roomState) requireConnected(record *RoomRecord, participant string) error {
+	if record.hasParticipant(participant) {
+		return nil
+	}
+	err := fmt.Errorf("participant %s is not connected", participant)
+	return connect.NewError(
+		connect.CodeFailedPrecondition,
+		err,
+	)
+}
+
+func (s *pokerServer) TransferFacilitator(ctx context.Context, req *connect.Request[pokerv1.TransferFacilitatorRequest]) (*connect.Response[pokerv1.TransferFacilitatorResponse], error) {
+	loggerFrom(ctx).Debug("TransferFacilitator function was invoked", "target", req.Msg.ParticipantId)
+
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		record, err := st.authorizeFacilitator(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+		if req.Msg.ParticipantId == req.Msg.Id {
+			return connect.NewError(
+				connect.CodeInvalidArgument,
+				errors.New("you are already the facilitator"),
+			)
+		}
+		if err := st.requireConnected(record, req.Msg.ParticipantId); err != nil {
+			return err
+		}
+
+		if err := st.store.SetRole(ctx, st.id, req.Msg.ParticipantId, pokerv1.Role_ROLE_FACILITATOR); err != nil {
+			return roomNotFoundOr(st.id, err)
+		}
+		if err := st.store.SetRole(ctx, st.id, req.Msg.Id, pokerv1.Role_ROLE_VOTER); err != nil {
+			return roomNotFoundOr(st.id, err)
+		}
+		st.broadcast(newRoleChangedEvent(req.Msg.ParticipantId, pokerv1.Role_ROLE_FACILITATOR))
+		st.broadcast(newRoleChangedEvent(req.Msg.Id, pokerv1.Role_ROLE_VOTER))
+		st.checkAutoReveal(ctx)
+		st.touch(ctx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pokerv1.TransferFacilitatorResponse{
+		Message: "accepted",
+	}), nil
+}
+
+func (s *pokerServer) SetRole(ctx context.Context, req *connect.Request[pokerv1.SetRoleRequest]) (*connect.Response[pokerv1.SetRoleResponse], error) {
+	loggerFrom(ctx).Debug("SetRole function was invoked", "target", req.Msg.ParticipantId, "role", req.Msg.Role.String())
+
+	switch req.Msg.Role {
+	case pokerv1.Role_ROLE_FACILITATOR, pokerv1.Role_ROLE_VOTER, pokerv1.Role_ROLE_OBSERVER:
+	default:
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("invalid role %v", req.Msg.Role),
+		)
+	}
+
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		record, err := st.authorizeFacilitator(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+		if err := st.requireConnected(record, req.Msg.ParticipantId); err != nil {
+			return err
+		}
+
+		// 最後のファシリテータを降格させると、誰もルームを操作できなくなる
+		current := record.roleOf(req.Msg.ParticipantId)
+		if current == pokerv1.Role_ROLE_FACILITATOR && req.Msg.Role != pokerv1.Role_ROLE_FACILITATOR && st.connectedFacilitators(record) <= 1 {
+			return connect.NewError(
+				connect.CodeFailedPrecondition,
+				ErrNoFacilitator,
+			)
+		}
+
+		if err := st.store.SetRole(ctx, st.id, req.Msg.ParticipantId, req.Msg.Role); err != nil {
+			return roomNotFoundOr(st.id, err)
+		}
+		// オブザーバーになった参加者の投票は、統計に含めない
+		if _, voted := record.Votes[req.Msg.ParticipantId]; voted && req.Msg.Role == pokerv1.Role_ROLE_OBSERVER {
+			if err := st.store.ClearVote(ctx, st.id, req.Msg.ParticipantId); err != nil {
+				return roomNotFoundOr(st.id, err)
+			}
+			st.broadcast(newResetVoteEvent(req.Msg.ParticipantId))
+		}
+		if current != req.Msg.Role {
+			st.broadcast(newRoleChangedEvent(req.Msg.ParticipantId, req.Msg.Role))
+			st.checkAutoReveal(ctx)
+		}
+		st.touch(ctx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pokerv1.SetRoleResponse{
+		Message: "accepted",
+	}), nil
+}
@@ -0,0 +1,740 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/google/uuid"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+// maxEventLogSize ルームごとに保持しておくイベントの件数。
+// 再接続したクライアントには、この範囲に残っているイベントを再送する。
+const maxEventLogSize = 256
+
+// DefaultQueueSize クライアントごとに、まだ送っていないイベントを溜めておける件数の既定値
+const DefaultQueueSize = 64
+
+// DefaultSessionTTL ストリームを受け持つインスタンスが、セッションの期限を延ばさずにいられる時間の既定値。
+// インスタンスはこの3分の1ごとに期限を延ばす。
+const DefaultSessionTTL = 30 * time.Second
+
+var (
+	ErrAlreadyConnected = errors.New("already connected")
+	ErrSlowConsumer     = errors.New("disconnected because too many events are pending")
+)
+
+// errRoomReleased このインスタンスがルームを手放した後に、ルームのゴルーチンに処理を依頼した場合のエラー。
+// ルームはストアに残っているので、RoomMap.doは管理を始め直してやり直す。
+var errRoomReleased = fmt.Errorf("%w: released by this instance", ErrRoomNotFound)
+
+// RoomMap 接続の管理を始めたルームを保持する構造体。
+// ルームのゴルーチンが終了すると、ここからも削除される。
+// queueSizeは、各クライアントの送信待ちのキューの大きさ。
+// これを超えて遅れたクライアントは切断する。
+// maxParticipantsは、1つのルームに同時に接続できるクライアントの数で、0の場合は上限を設けない。
+// drainingはサーバの停止中に真になり、その後に管理を始めるルームは新しいストリームを受け付けない。
+// busは他のインスタンスとイベントをやり取りするためのもので、originはバスの上でこのインスタンスを表すID。
+// sessionTTLは、このインスタンスが受け持つ参加者のセッションを、ストアで延ばす期限の長さ。
+type RoomMap struct {
+	mu              sync.Mutex
+	store           RoomStore
+	bus             Bus
+	origin          string
+	queueSize       int
+	maxParticipants int
+	sessionTTL      time.Duration
+	metrics         *serverMetrics
+	rooms           map[string]*Room
+	draining        bool
+}
+
+func NewRoomMap(store RoomStore, bus Bus, queueSize, maxParticipants int, metrics *serverMetrics) *RoomMap {
+	return &RoomMap{
+		store:           store,
+		bus:             bus,
+		origin:          uuid.NewString(),
+		queueSize:       queueSize,
+		maxParticipants: maxParticipants,
+		sessionTTL:      DefaultSessionTTL,
+		metrics:         metrics,
+		rooms:           make(map[string]*Room),
+	}
+}
+
+// Room ルームの状態を所有するゴルーチンへの窓口。
+// 参加者とのストリームやイベントログ、ストアに保存された投票やロールは、
+// 全てこのゴルーチンがcommandsから受け取った順に一つずつ読み書きするので、ロックを取る必要はない。
+// releasedは、ゴルーチンがルームを閉じずに手放して終了した場合に、doneを閉じる前に真になる。
+type Room struct {
+	id       string
+	commands chan func(*roomState)
+	done     chan struct{}
+	released bool
+}
+
+// roomState ルームのゴルーチンだけが触る状態。
+// streamsは、クライアントのIDをキーとして、クライアントとの接続を保持する。
+// ブロードキャストしたイベントにはルーム内で単調増加するシーケンス番号を振り、
+// 直近maxEventLogSize件をeventsに保持する。
+// roomは、タイマーなど別のゴルーチンから、このルームのゴルーチンに処理を依頼するために使う。
+// pendingRevealは予約した自動での公開で、閉じると取り消せる。
+// drainingはサーバの停止のためにストリームを終了させた後に真になる。
+// releasedは、ストアのルームを残したまま、このインスタンスでの管理をやめる場合に真になる。
+// adoptedは、停止したインスタンスのイベントログを読み込んで、その続きからシーケンス番号を振っている場合の、そのインスタンスのID。
+// streamsはこのインスタンスに接続しているクライアントだけを持つ。他のインスタンスに接続している参加者も含めて
+// 扱う場合は、ストアに記録された参加者を使う。
+type roomState struct {
+	id              string
+	room            *Room
+	store           RoomStore
+	bus             Bus
+	origin          string
+	metrics         *serverMetrics
+	maxParticipants int
+	sessionTTL      time.Duration
+	streams         map[string]StreamState
+	seq             uint64
+	events          []*pokerv1.ConnectResponse
+	timer           *roundTimer
+	pendingReveal   chan struct{}
+	draining        bool
+	adopted         string
+	closed          bool
+	released        bool
+}
+
+// StreamState クライアントとのストリームと、そのクライアントに発行したセッショントークン。
+// ルームのゴルーチンはイベントをqueueに入れるだけで、streamへの送信はそのクライアントのハンドラが行う。
+// そのため、一つのクライアントの通信が詰まっても、他のクライアントへの配信は止まらない。
+type StreamState struct {
+	ctx    context.Context
+	cancel context.CancelCauseFunc
+	stream *connect.ServerStream[pokerv1.ConnectResponse]
+	token  string
+	queue  chan *pokerv1.ConnectResponse
+}
+
+// get roomIdに対応するRoomを返す。接続の管理を始めていなければfalseを返す。
+func (m *RoomMap) get(roomId string) (*Room, bool) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r, ok := m.rooms[roomId]
+	return r, ok
+}
+
+// all 接続の管理をしているルームを返す
+func (m *RoomMap) all() []*Room {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	rooms := make([]*Room, 0, len(m.rooms))
+	for _, r := range m.rooms {
+		rooms = append(rooms, r)
+	}
+	return rooms
+}
+
+// drain 以降に管理を始めるルームで新しいストリームを受け付けないようにし、管理しているルームを返す
+func (m *RoomMap) drain() []*Room {
+	m.mu.Lock()
+	m.draining = true
+	m.mu.Unlock()
+	return m.all()
+}
+
+// isDraining サーバの停止中かどうかを返す
+func (m *RoomMap) isDraining() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.draining
+}
+
+// len 接続の管理をしているルームの数を返す
+func (m *RoomMap) len() int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return len(m.rooms)
+}
+
+// getOrCreate roomIdに対応するRoomを返す。まだなければ作成し、ゴルーチンを起動する。
+func (m *RoomMap) getOrCreate(roomId string) *Room {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	r, ok := m.rooms[roomId]
+	if !ok {
+		r = &Room{
+			id:       roomId,
+			commands: make(chan func(*roomState)),
+			done:     make(chan struct{}),
+		}
+		m.rooms[roomId] = r
+		go m.run(r, &roomState{
+			id:              roomId,
+			room:            r,
+			store:           m.store,
+			bus:             m.bus,
+			origin:          m.origin,
+			metrics:         m.metrics,
+			maxParticipants: m.maxParticipants,
+			sessionTTL:      m.sessionTTL,
+			streams:         make(map[string]StreamState, 1),
+			draining:        m.draining,
+		})
+	}
+	return r
+}
+
+// run ルームのゴルーチン。ルームが閉じられるか手放されるまでコマンドを一つずつ実行する。
+// 他のインスタンスに接続している参加者のRPCのために管理を始めたルームは、処理を終えて待つものがなくなれば手放す。
+func (m *RoomMap) run(r *Room, st *roomState) {
+	defer close(r.done)
+	st.restore(context.Background())
+	unsubscribe := m.subscribe(r)
+	defer unsubscribe()
+	go m.heartbeat(r)
+	for cmd := range r.commands {
+		cmd(st)
+		if !st.closed && st.idle() {
+			st.release(context.Background())
+		}
+		if st.closed {
+			r.released = st.released
+			m.mu.Lock()
+			if m.rooms[r.id] == r {
+				delete(m.rooms, r.id)
+			}
+			m.mu.Unlock()
+			if !st.released {
+				slog.Info("room is closed", "room_id", r.id)
+			}
+			return
+		}
+	}
+}
+
+// heartbeat rのルームのゴルーチンが終了するまで、sessionTTLの3分の1ごとにセッションの期限の確認を依頼する
+func (m *RoomMap) heartbeat(r *Room) {
+	ticker := time.NewTicker(m.sessionTTL / 3)
+	defer ticker.Stop()
+
+	ctx := context.Background()
+	for {
+		select {
+		case <-r.done:
+			return
+		case <-ticker.C:
+			err := r.do(ctx, func(st *roomState) error {
+				st.heartbeat(ctx)
+				return nil
+			})
+			if err != nil {
+				return
+			}
+		}
+	}
+}
+
+// subscribe 他のインスタンスがバスで配ったrのルームのイベントを、ルームのゴルーチンで受け取るようにする。
+// 購読できなかった場合は、このインスタンスのクライアントにだけ配る。
+func (m *RoomMap) subscribe(r *Room) func() {
+	ctx, cancel := context.WithTimeout(context.Background(), busTimeout)
+	defer cancel()
+	unsubscribe, err := m.bus.Subscribe(ctx, r.id, func(b []byte) {
+		msg, res, err := decodeBusMessage(b)
+		if err != nil {
+			slog.Error("failed to decode bus message", "room_id", r.id, "error", err)
+			return
+		}
+		if msg.Origin == m.origin {
+			return
+		}
+		err = r.do(context.Background(), func(st *roomState) error {
+			st.receive(context.Background(), res, msg.Logged)
+			return nil
+		})
+		if err != nil && !errors.Is(err, ErrRoomNotFound) {
+			slog.Error("failed to receive bus message", "room_id", r.id, "error", err)
+		}
+	})
+	if err != nil {
+		slog.Error("failed to subscribe to bus", "room_id", r.id, "error", err)
+		return func() {}
+	}
+	return unsubscribe
+}
+
+// publish このインスタンスで接続の管理をしていないルームのイベントを、バスで他のインスタンスに配る
+func (m *RoomMap) publish(ctx context.Context, roomId string, res *pokerv1.ConnectResponse) error {
+	return publishEvent(ctx, m.bus, m.origin, roomId, res, true)
+}
+
+// do roomIdのルームのゴルーチンでfを実行し、その結果を返す。
+// このインスタンスで接続の管理を始めていないルームは、ここで管理を始める。
+// 依頼する前にルームが手放された場合は、管理を始め直してやり直す。
+func (m *RoomMap) do(ctx context.Context, roomId string, f func(st *roomState) error) error {
+	for {
+		err := m.getOrCreate(roomId).do(ctx, f)
+		if !errors.Is(err, errRoomReleased) {
+			return err
+		}
+	}
+}
+
+// do fをルームのゴルーチンで実行し、その結果を返す。
+// ルームが既に閉じられている場合はErrRoomNotFoundを、手放された場合はerrRoomReleasedを返す。
+func (r *Room) do(ctx context.Context, f func(st *roomState) error) error {
+	errc := make(chan error, 1)
+	select {
+	case r.commands <- func(st *roomState) { errc <- f(st) }:
+	case <-r.done:
+		if r.released {
+			return errRoomReleased
+		}
+		return ErrRoomNotFound
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+	return <-errc
+}
+
+// joinRequest ルームに参加するクライアントの情報。
+// tokenはこのストリームに発行したセッショントークン、presentedは再接続の際にクライアントが提示したトークン。
+// resumeAfterは再接続の際に、最後に受け取ったイベントのシーケンス番号。
+// observerが真の場合は、オブザーバーとして参加する。
+// admittedは、ルームの合言葉を確認済みか、合言葉が不要な場合に真。
+type joinRequest struct {
+	name        string
+	token       string
+	presented   string
+	resumeAfter uint64
+	observer    bool
+	admitted    bool
+}
+
+// connect join.nameのクライアントとのストリームを、発行したセッショントークンと共に登録する。
+// クライアントに送るイベントはqueueに入れる。
+// resumeAfterが0の場合は、ルームの状態を送り、参加したことを全員に通知する。
+// resumeAfterが指定された場合は、それより後のイベントを再送し、同じnameの古いストリームがあればcancelして置き換える。
+// 古いストリームを置き換えるには、presentedがそのストリームに発行したトークンと一致している必要がある。
+// 新しく参加する場合は、ルームがロックされておらず、合言葉を確認済みである必要がある。
+// また、observerに応じてオブザーバーにするか、オブザーバーだった参加者を投票者に戻す。
+// 切断されたクライアントが、その時のトークンをpresentedに付けて再開する場合は、
+// どのインスタンスに接続し直しても、ロックや合言葉を確かめず、ストアに残したロールのまま参加させる。
+// トークンを付けずに同じ名前で参加した場合は、新しい参加者として扱う。
+// サーバの停止中はErrServerShutdownを返す。
+// 再送すべきイベントが既にeventsから消えている場合や、queueに入りきらない場合は、resumeAfterが0の場合と同様にルームの状態を送る。
+// 他のインスタンスに接続していたストリームも、presentedがそのストリームに発行したトークンと一致していれば置き換えられる。
+// 前のインスタンスが落ちていても、セッションの期限が切れるのを待たずに接続し直せる。
+// シーケンス番号はインスタンスごとに振るので、別のインスタンスから移ってきたクライアントには、再送せずにルームの状態を送る。
+// 停止したインスタンスから移ってきたクライアントには、そのインスタンスが保存したイベントログから再送する。
+// 戻り値は、古いストリームを置き換えたかどうか。
+func (st *roomState) connect(ctx context.Context, cancel context.CancelCauseFunc, stream *connect.ServerStream[pokerv1.ConnectResponse], queue chan *pokerv1.ConnectResponse, join joinRequest) (bool, error) {
+	name := join.name
+	if st.draining {
+		return false, ErrServerShutdown
+	}
+	old, replaced := st.streams[name]
+	if replaced && join.resumeAfter == 0 {
+		return false, ErrAlreadyConnected
+	}
+	if replaced && !sameToken(join.presented, old.token) {
+		return false, ErrInvalidSession
+	}
+	var previous Session
+	var previousLog *ResumeState
+	var restored, moved bool
+	if !replaced {
+		// 落ちたインスタンスに残された参加者がいれば、同じ名前で参加できるように外しておく
+		st.expireSessions(ctx)
+		record, err := st.store.GetRoom(ctx, st.id)
+		if err != nil {
+			return false, err
+		}
+		var found bool
+		previous, found = record.Sessions[name]
+		// 他のインスタンスで開始したタイマーや、ルームの管理を始める前に開始したタイマーも、このインスタンスでカウントダウンする
+		if record.Timer != nil {
+			st.followTimer(record.Timer)
+		}
+		if found && join.resumeAfter > 0 && join.presented != "" && sameToken(hashToken(join.presented), previous.TokenHash) {
+			moved = record.hasParticipant(name)
+			restored = !moved
+			// 参加し直すと、誰も参照しなくなったイベントログはストアから消えるので、先に取り出しておく
+			previousLog = record.ResumeLogs[previous.Instance]
+		}
+		if !restored && !moved {
+			if err := st.admit(ctx, join); err != nil {
+				return false, err
+			}
+		}
+		// 切断される前のトークンを示さずに同じ名前で参加した場合は、別の参加者として前のセッションとロールを引き継がせない
+		if found && !restored && !moved {
+			if err := st.store.RemoveParticipant(ctx, st.id, name); err != nil {
+				st.logger(ctx).Error("failed to remove participant", "error", err)
+			}
+		}
+	}
+
+	session := Session{TokenHash: hashToken(join.token), Instance: st.origin, ExpiresAt: time.Now().Add(st.sessionTTL)}
+	if err := st.store.AddParticipant(ctx, st.id, name, session); err != nil {
+		st.logger(ctx).Error("failed to add participant", "error", err)
+		if len(st.streams) == 0 {
+			// 接続の管理を始めた直後にルームが削除されていた
+			st.closed = true
+		}
+		return false, err
+	}
+	if replaced {
+		old.cancel(nil)
+	}
+	st.streams[name] = StreamState{
+		ctx:    ctx,
+		cancel: cancel,
+		stream: stream,
+		token:  join.token,
+		queue:  queue,
+	}
+	defer st.touch(ctx)
+	if !replaced && !restored && !moved {
+		st.applyObserver(ctx, name, join.observer)
+	}
+
+	if missed, ok := st.missedEvents(ctx, join.resumeAfter, replaced, restored, previous, previousLog); ok && len(missed) < cap(queue) {
+		for _, res := range missed {
+			queue <- res
+		}
+	} else {
+		// クライアントがルームに参加した際の、他ユーザの接続状況を通知する
+		record, err := st.store.GetRoom(ctx, st.id)
+		if err != nil {
+			st.logger(ctx).Error("failed to get room", "error", err)
+			return replaced, nil
+		}
+		res := newStatusEvent(record.names(), record, timerStatus(record))
+		res.Sequence = st.seq
+		queue <- res
+	}
+
+	// 参加したことを全ユーザに通知する
+	// 再接続の場合は他のユーザから見ると参加し続けているので、通知しない
+	if replaced || moved {
+		st.logger(ctx).Info("connection resumed", "moved", moved)
+		return replaced, nil
+	}
+	record, err := st.store.GetRoom(ctx, st.id)
+	if err != nil {
+		st.logger(ctx).Error("failed to get room", "error", err)
+		return false, nil
+	}
+	st.broadcast(newJoinEvent(name, record.roleOf(name)))
+	st.checkAutoReveal(ctx)
+	return false, nil
+}
+
+// missedEvents 接続を再開したクライアントが、afterより後に見逃したイベントを返す。
+// 置き換えた場合や、previousのセッションを発行したインスタンスの続きの番号を振っている場合は、このルームのイベントログから返す。
+// 停止した他のインスタンスで切断された場合は、そのインスタンスが保存したイベントログpreviousLogから返す。
+// 再送できない場合はfalseを返す。
+func (st *roomState) missedEvents(ctx context.Context, after uint64, replaced, restored bool, previous Session, previousLog *ResumeState) ([]*pokerv1.ConnectResponse, bool) {
+	switch {
+	case replaced:
+		return st.eventsAfter(after)
+	case !restored:
+		return nil, false
+	case previous.Instance == st.origin || previous.Instance == st.adopted && st.adopted != "":
+		return st.eventsAfter(after)
+	}
+	if previousLog == nil {
+		return nil, false
+	}
+	events, err := previousLog.decode()
+	if err != nil {
+		st.logger(ctx).Error("failed to unmarshal event", "error", err)
+		return nil, false
+	}
+	return eventsAfter(events, previousLog.Sequence, after)
+}
+
+// disconnect nameのストリームを削除し、退出したことを全員に通知する。
+// 再接続によって既に別のストリームに置き換えられている場合は何もしない。
+func (st *roomState) disconnect(ctx context.Context, name string, stream *connect.ServerStream[pokerv1.ConnectResponse]) {
+	state, ok := st.streams[name]
+	if !ok || state.stream != stream {
+		return
+	}
+	st.remove(ctx, name, pokerv1.LeaveReason_LEAVE_REASON_DISCONNECTED, nil)
+}
+
+// remove nameをルームから外し、reasonと共に退出したことを全員に通知する。
+// このインスタンスに接続している場合は、ストリームをcauseを理由に終了させ、外された本人にもキューに空きがあれば同じイベントを送る。
+// 他のインスタンスに接続している場合は、通知を受け取ったインスタンスがストリームを終了させる。
+// 切断された場合は、接続を再開できるようにセッションとロールを残す。既に他のインスタンスで接続を再開していれば、ストリームを終了させるだけで通知しない。
+// 参加者がいなくなった場合はルームを閉じる。
+func (st *roomState) remove(ctx context.Context, name string, reason pokerv1.LeaveReason, cause error) {
+	state, local := st.streams[name]
+	delete(st.streams, name)
+
+	var err error
+	switch reason {
+	case pokerv1.LeaveReason_LEAVE_REASON_LEFT, pokerv1.LeaveReason_LEAVE_REASON_KICKED:
+		err = st.store.RemoveParticipant(ctx, st.id, name)
+	default:
+		err = st.store.SuspendParticipant(ctx, st.id, name, st.origin)
+	}
+	if errors.Is(err, ErrSessionMoved) {
+		if local {
+			state.cancel(cause)
+		}
+		return
+	}
+	if err != nil && !errors.Is(err, ErrRoomNotFound) {
+		st.logger(ctx).Error("failed to remove participant", "error", err)
+	}
+
+	res := newLeaveEvent(name, reason)
+	st.broadcast(res)
+	if local {
+		select {
+		case state.queue <- res:
+		default:
+		}
+		state.cancel(cause)
+	}
+
+	// 参加者がいなくなったらルームを削除する
+	// 他のインスタンスも含めて残っている場合は、ファシリテータが不在にならないようにする
+	st.expireSessions(ctx)
+	record, err := st.store.GetRoom(ctx, st.id)
+	if err != nil || len(record.Participants) == 0 {
+		st.close(ctx, "")
+		return
+	}
+	st.ensureFacilitator(ctx)
+	st.checkAutoReveal(ctx)
+}
+
+// close 閉じたことを全員に通知して全てのストリームを切断し、ストアからルームを削除してゴルーチンを終了させる。
+// closedByはルームを閉じたファシリテータで、サーバが閉じた場合は空。
+func (st *roomState) close(ctx context.Context, closedBy string) {
+	st.stopTimer()
+	st.cancelAutoReveal()
+	// このインスタンスに接続しているクライアントがいなくても、他のインスタンスには知らせる
+	st.broadcast(newRoomClosedEvent(closedBy))
+	for _, state := range st.streams {
+		state.cancel(ErrRoomClosed)
+	}
+	st.streams = nil
+	if err := st.store.DeleteRoom(ctx, st.id); err != nil && !errors.Is(err, ErrRoomNotFound) {
+		st.logger(ctx).Error("failed to delete room", "error", err)
+	}
+	st.closed = true
+}
+
+// eventsAfter seqより後のイベントを返す。
+// 途中のイベントが既に捨てられている場合や、seqがまだ振られていない番号の場合はfalseを返す。
+func (st *roomState) eventsAfter(seq uint64) ([]*pokerv1.ConnectResponse, bool) {
+	return eventsAfter(st.events, st.seq, seq)
+}
+
+// eventsAfter lastまでのシーケンス番号を振ったイベントログeventsから、seqより後のイベントを返す
+func eventsAfter(events []*pokerv1.ConnectResponse, last, seq uint64) ([]*pokerv1.ConnectResponse, bool) {
+	if seq > last {
+		return nil, false
+	}
+	if seq == last {
+		return nil, true
+	}
+	if len(events) == 0 || events[0].Sequence > seq+1 {
+		return nil, false
+	}
+	i := int(seq + 1 - events[0].Sequence)
+	return events[i:], true
+}
+
+// heartbeat このインスタンスが受け持つ参加者のセッションの期限を延ばし、期限が切れた参加者を外す。
+// 他のインスタンスで接続を再開した参加者や、期限を延ばせないうちに外された参加者のストリームは終了させる。
+func (st *roomState) heartbeat(ctx context.Context) {
+	if len(st.streams) == 0 {
+		return
+	}
+	err := st.store.RefreshSessions(ctx, st.id, st.origin, time.Now().Add(st.sessionTTL))
+	if err != nil {
+		if !errors.Is(err, ErrRoomNotFound) {
+			st.logger(ctx).Error("failed to refresh sessions", "error", err)
+		}
+		return
+	}
+	record, err := st.store.GetRoom(ctx, st.id)
+	if err != nil {
+		st.logger(ctx).Error("failed to get room", "error", err)
+		return
+	}
+	for name, state := range st.streams {
+		if session, ok := record.Sessions[name]; ok && session.Instance == st.origin && record.hasParticipant(name) {
+			continue
+		}
+		st.logger(ctx).Info("session is no longer owned by this instance", "participant", name)
+		delete(st.streams, name)
+		state.cancel(ErrSessionMoved)
+	}
+	st.expireSessions(ctx)
+}
+
+// expireSessions 期限が切れたセッションの参加者がいれば外し、切断されたことを全員に通知する。
+// 期限が切れた参加者がいない場合は、ストアに書き込まない。
+func (st *roomState) expireSessions(ctx context.Context) {
+	record, err := st.store.GetRoom(ctx, st.id)
+	if err != nil || len(record.expiredParticipants(time.Now())) == 0 {
+		return
+	}
+	expired, err := st.store.ExpireSessions(ctx, st.id, time.Now())
+	if err != nil {
+		st.logger(ctx).Error("failed to expire sessions", "error", err)
+		return
+	}
+	for _, name := range expired {
+		st.logger(ctx).Info("session expired", "participant", name)
+		st.broadcast(newLeaveEvent(name, pokerv1.LeaveReason_LEAVE_REASON_DISCONNECTED))
+	}
+	if len(expired) > 0 {
+		st.ensureFacilitator(ctx)
+		st.checkAutoReveal(ctx)
+	}
+}
+
+// idle このインスタンスに接続しているクライアントがおらず、タイマーや予約した公開など、ルームのゴルーチンで待つものもないかを返す。
+// サーバの停止中は、状態を保存するまで手放さない。
+func (st *roomState) idle() bool {
+	return len(st.streams) == 0 && st.timer == nil && st.pendingReveal == nil && !st.draining
+}
+
+// touch ルームの最終利用時刻を更新する
+func (st *roomState) touch(ctx context.Context) {
+	err := st.store.Touch(ctx, st.id, time.Now())
+	if err != nil && !errors.Is(err, ErrRoomNotFound) {
+		st.logger(ctx).Error("failed to touch room", "error", err)
+	}
+}
+
+// broadcast resをこのインスタンスのクライアントに配り、バスで他のインスタンスにも配る。
+func (st *roomState) broadcast(res *pokerv1.ConnectResponse) {
+	st.deliver(res)
+	st.share(res, true)
+}
+
+// deliver resにシーケンス番号を振り、イベントログに追加した上で、このインスタンスの全てのクライアントのキューに入れる。
+func (st *roomState) deliver(res *pokerv1.ConnectResponse) {
+	st.seq++
+	res.Sequence = st.seq
+	st.events = append(st.events, res)
+	if len(st.events) > maxEventLogSize {
+		st.events = append(st.events[:0:0], st.events[len(st.events)-maxEventLogSize:]...)
+	}
+	st.enqueue(res)
+}
+
+// publish resをイベントログに残さずに、このインスタンスと他のインスタンスの全てのクライアントに配る。
+func (st *roomState) publish(res *pokerv1.ConnectResponse) {
+	st.enqueue(res)
+	st.share(res, false)
+}
+
+// share resをバスで他のインスタンスに配る。loggedは、受け取ったインスタンスでイベントログに残すかどうか。
+// 配れなかった場合も、このインスタンスのクライアントには配っているので、ログに残すだけにする。
+func (st *roomState) share(res *pokerv1.ConnectResponse, logged bool) {
+	if st.bus == nil {
+		return
+	}
+	if err := publishEvent(context.Background(), st.bus, st.origin, st.id, res, logged); err != nil {
+		slog.Error("failed to publish event to bus", "room_id", st.id, "error", err)
+	}
+}
+
+// receive 他のインスタンスが配ったイベントを、このインスタンスのクライアントに届ける。
+// 他のインスタンスで退出させたり外したりした参加者や、閉じられたルームのストリームは、ここで終了させる。
+// 他のインスタンスで開始されたタイマーはここでカウントダウンを始め、公開や新しいラウンド、期限切れで止める。
+// ストアは他のインスタンスが更新済みなので、ここでは変更しない。
+func (st *roomState) receive(ctx context.Context, res *pokerv1.ConnectResponse, logged bool) {
+	if logged {
+		st.deliver(res)
+	} else {
+		st.enqueue(res)
+	}
+
+	switch e := res.Event.(type) {
+	case *pokerv1.ConnectResponse_ParticipantLeft:
+		state, ok := st.streams[e.ParticipantLeft.ParticipantId]
+		if !ok {
+			return
+		}
+		var cause error
+		switch e.ParticipantLeft.Reason {
+		case pokerv1.LeaveReason_LEAVE_REASON_LEFT:
+			cause = ErrLeft
+		case pokerv1.LeaveReason_LEAVE_REASON_KICKED:
+			cause = ErrKicked
+		default:
+			return
+		}
+		delete(st.streams, e.ParticipantLeft.ParticipantId)
+		state.cancel(cause)
+	case *pokerv1.ConnectResponse_TimerStarted:
+		record, err := st.store.GetRoom(ctx, st.id)
+		if err != nil {
+			st.logger(ctx).Error("failed to get room", "error", err)
+			return
+		}
+		if record.Timer != nil {
+			st.followTimer(record.Timer)
+		}
+	case *pokerv1.ConnectResponse_TimerExpired, *pokerv1.ConnectResponse_VotesRevealed, *pokerv1.ConnectResponse_RoundStarted:
+		st.stopTimer()
+	case *pokerv1.ConnectResponse_RoomClosed:
+		st.stopTimer()
+		st.cancelAutoReveal()
+		for _, state := range st.streams {
+			state.cancel(ErrRoomClosed)
+		}
+		st.streams = nil
+		st.closed = true
+	}
+}
+
+// release このインスタンスに接続しているクライアントはいなくなったが、ストアにルームが残っている場合に、
+// ルームは残したまま、このインスタンスでの接続の管理をやめてゴルーチンを終了させる。
+func (st *roomState) release(ctx context.Context) {
+	st.stopTimer()
+	st.cancelAutoReveal()
+	st.closed = true
+	st.released = true
+	st.logger(ctx).Debug("room is released")
+}
+
+// enqueue resをこのインスタンスの全てのクライアントのキューに入れる。
+// キューが一杯のクライアントは、イベントを受け取れていないので切断する。
+func (st *roomState) enqueue(res *pokerv1.ConnectResponse) {
+	var slow []string
+	for id, state := range st.streams {
+		select {
+		case state.queue <- res:
+		default:
+			slow = append(slow, id)
+		}
+	}
+	for _, id := range slow {
+		st.metrics.broadcastFailures.WithLabelValues(failureSlowConsumer).Inc()
+		slog.Warn("participant is too slow to receive events", "room_id", st.id, "participant", id)
+		st.remove(context.Background(), id, pokerv1.LeaveReason_LEAVE_REASON_SLOW_CONSUMER, ErrSlowConsumer)
+	}
+}
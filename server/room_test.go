@@ -0,0 +1,143 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus/testutil"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+// newTestRoomState ルームのゴルーチンを介さずに、roomStateを直接操作するためのルームを作る
+func newTestRoomState(tb testing.TB, roomId string) *roomState {
+	tb.Helper()
+
+	store := NewMemoryRoomStore()
+	err := store.CreateRoom(context.Background(), &RoomRecord{ID: roomId, Deck: defaultDeck(), Votes: make(map[string]string)})
+	if err != nil {
+		tb.Fatal(err)
+	}
+	return &roomState{
+		id:      roomId,
+		store:   store,
+		metrics: newServerMetrics(),
+		streams: make(map[string]StreamState),
+	}
+}
+
+// subscribe nameのクライアントを、queueSizeの大きさのキューで登録する
+func subscribe(tb testing.TB, st *roomState, name string, queueSize int) StreamState {
+	tb.Helper()
+
+	if err := st.store.AddParticipant(context.Background(), st.id, name, Session{TokenHash: hashToken(name), Instance: st.origin, ExpiresAt: time.Now().Add(time.Hour)}); err != nil {
+		tb.Fatal(err)
+	}
+	ctx, cancel := context.WithCancelCause(context.Background())
+	state := StreamState{
+		ctx:    ctx,
+		cancel: cancel,
+		queue:  make(chan *pokerv1.ConnectResponse, queueSize),
+	}
+	st.streams[name] = state
+	return state
+}
+
+// hasStream nameのクライアントが、このインスタンスに接続中かどうかを返す
+func hasStream(st *roomState, name string) bool {
+	_, ok := st.streams[name]
+	return ok
+}
+
+func TestSlowConsumerIsEvicted(t *testing.T) {
+	const queueSize = 4
+	st := newTestRoomState(t, "slow")
+	taro := subscribe(t, st, "Taro", queueSize)
+	stuck := subscribe(t, st, "Stuck", queueSize)
+	if err := st.store.SetRole(context.Background(), st.id, "Taro", pokerv1.Role_ROLE_FACILITATOR); err != nil {
+		t.Fatal(err)
+	}
+
+	var received []*pokerv1.ConnectResponse
+	for i := 0; i < queueSize+1; i++ {
+		st.broadcast(newVoteEvent("Taro"))
+		for len(taro.queue) > 0 {
+			received = append(received, <-taro.queue)
+		}
+	}
+
+	if hasStream(st, "Stuck") {
+		t.Fatal("stuck consumer must be disconnected")
+	}
+	if !hasStream(st, "Taro") {
+		t.Fatal("other consumers must stay connected")
+	}
+	if cause := context.Cause(stuck.ctx); !errors.Is(cause, ErrSlowConsumer) {
+		t.Fatalf("unexpected cause %v", cause)
+	}
+
+	left := received[len(received)-1].GetParticipantLeft()
+	if left.GetParticipantId() != "Stuck" || left.GetReason() != pokerv1.LeaveReason_LEAVE_REASON_SLOW_CONSUMER {
+		t.Fatalf("unexpected leave event %v", received[len(received)-1])
+	}
+	if len(received) != queueSize+2 {
+		t.Fatalf("expected %d events, got %d", queueSize+2, len(received))
+	}
+	if n := testutil.ToFloat64(st.metrics.broadcastFailures.WithLabelValues(failureSlowConsumer)); n != 1 {
+		t.Fatalf("expected 1 broadcast failure, got %v", n)
+	}
+}
+
+// BenchmarkBroadcast 全てのクライアントのキューから1件のイベントが取り出されるまでの時間を計る。
+// stuckの場合は、イベントを全く受け取らないクライアントが1人混ざっている。
+func BenchmarkBroadcast(b *testing.B) {
+	for _, participants := range []int{100, 500} {
+		for _, stuck := range []bool{false, true} {
+			b.Run(fmt.Sprintf("participants=%d/stuck=%t", participants, stuck), func(b *testing.B) {
+				benchmarkBroadcast(b, participants, stuck)
+			})
+		}
+	}
+}
+
+func benchmarkBroadcast(b *testing.B, participants int, stuck bool) {
+	st := newTestRoomState(b, "bench")
+
+	var wg sync.WaitGroup
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	for i := 0; i < participants; i++ {
+		state := subscribe(b, st, fmt.Sprintf("participant-%03d", i), DefaultQueueSize)
+		go func() {
+			for {
+				select {
+				case res := <-state.queue:
+					if res.Type == pokerv1.MessageType_MESSAGE_TYPE_VOTE {
+						wg.Done()
+					}
+				case <-ctx.Done():
+					return
+				}
+			}
+		}()
+	}
+	if stuck {
+		subscribe(b, st, "stuck", DefaultQueueSize)
+	}
+
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		wg.Add(participants)
+		st.broadcast(newVoteEvent("participant-000"))
+		wg.Wait()
+	}
+	b.StopTimer()
+
+	if stuck && b.N > DefaultQueueSize && hasStream(st, "stuck") {
+		b.Fatal("stuck consumer must be disconnected")
+	}
+}
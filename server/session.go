@@ -0,0 +1,118 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+
+	"connectrpc.com/connect"
+
+	"github.com/machimachida/grpc-planning-poker/gen/proto/v1/pokerv1connect"
+)
+
+// sessionHeader セッショントークンをやり取りするヘッダ。
+// CreateRoomとConnectのレスポンスヘッダで発行し、クライアントはその後のリクエストヘッダに付けて送る。
+const sessionHeader = "Planning-Poker-Session"
+
+var (
+	ErrNoSession      = errors.New("session token is required")
+	ErrInvalidSession = errors.New("session token is invalid")
+)
+
+// sessionProcedures セッショントークンが必要なRPC
+var sessionProcedures = map[string]bool{
+	pokerv1connect.PlanningPokerServiceVoteProcedure:                true,
+	pokerv1connect.PlanningPokerServiceShowVotesProcedure:           true,
+	pokerv1connect.PlanningPokerServiceNewGameProcedure:             true,
+	pokerv1connect.PlanningPokerServiceTransferFacilitatorProcedure: true,
+	pokerv1connect.PlanningPokerServiceSetRoleProcedure:             true,
+	pokerv1connect.PlanningPokerServiceAddStoryProcedure:            true,
+	pokerv1connect.PlanningPokerServiceReorderStoriesProcedure:      true,
+	pokerv1connect.PlanningPokerServiceRemoveStoryProcedure:         true,
+	pokerv1connect.PlanningPokerServiceSelectStoryProcedure:         true,
+	pokerv1connect.PlanningPokerServiceAcceptEstimateProcedure:      true,
+	pokerv1connect.PlanningPokerServiceGetRoomHistoryProcedure:      true,
+	pokerv1connect.PlanningPokerServiceStartTimerProcedure:          true,
+	pokerv1connect.PlanningPokerServiceUpdateSettingsProcedure:      true,
+	pokerv1connect.PlanningPokerServiceLeaveRoomProcedure:           true,
+	pokerv1connect.PlanningPokerServiceKickParticipantProcedure:     true,
+	pokerv1connect.PlanningPokerServiceCloseRoomProcedure:           true,
+	pokerv1connect.PlanningPokerServiceSetRoomLockProcedure:         true,
+}
+
+// participantRequest 参加者がルームに対して行うリクエスト
+type participantRequest interface {
+	GetId() string
+	GetRoomId() string
+}
+
+// newSessionToken 推測できないセッショントークンを生成する
+func newSessionToken() string {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		// crypto/randが失敗するのは、OSの乱数源が使えない場合だけ
+		panic(err)
+	}
+	return base64.RawURLEncoding.EncodeToString(b)
+}
+
+// issueSession セッショントークンを発行し、ストリームのレスポンスヘッダに設定する。
+// ヘッダは最初のメッセージと一緒に送られるので、Sendより前に呼ぶ必要がある。
+func issueSession[T any](stream *connect.ServerStream[T]) string {
+	token := newSessionToken()
+	stream.ResponseHeader().Set(sessionHeader, token)
+	return token
+}
+
+// newSessionInterceptor sessionProceduresのRPCについて、
+// リクエストのidがroom_idのルームに接続中の参加者であり、ヘッダのトークンがその参加者のものであることを確認する。
+// セッションはストアに記録されているので、呼び出し元のストリームを他のインスタンスが受け持っていても確かめられる。
+func newSessionInterceptor(store RoomStore) connect.UnaryInterceptorFunc {
+	return func(next connect.UnaryFunc) connect.UnaryFunc {
+		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+			if !sessionProcedures[req.Spec().Procedure] {
+				return next(ctx, req)
+			}
+			msg, ok := req.Any().(participantRequest)
+			if !ok {
+				return next(ctx, req)
+			}
+
+			token := req.Header().Get(sessionHeader)
+			if token == "" {
+				return nil, connect.NewError(
+					connect.CodeUnauthenticated,
+					ErrNoSession,
+				)
+			}
+			record, err := store.GetRoom(ctx, msg.GetRoomId())
+			if err != nil && !errors.Is(err, ErrRoomNotFound) {
+				loggerFrom(ctx).Error("failed to get room", "error", err)
+				return nil, connect.NewError(connect.CodeInternal, err)
+			}
+			if err != nil || !record.authenticate(msg.GetId(), token) {
+				loggerFrom(ctx).Warn("invalid session token")
+				return nil, connect.NewError(
+					connect.CodeUnauthenticated,
+					ErrInvalidSession,
+				)
+			}
+			return next(ctx, req)
+		}
+	}
+}
+
+// hashToken ストアに保存するために、セッショントークンのハッシュを返す
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// sameToken 2つのトークンが一致するかを、比較にかかる時間から推測されないように確認する
+func sameToken(a, b string) bool {
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
@@ -0,0 +1,206 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+// maxAutoRevealDelay 自動で公開するまでに待てる最長の時間
+const maxAutoRevealDelay = time.Minute
+
+var (
+	ErrInvalidAutoRevealDelay = errors.New("auto_reveal_delay_seconds must be between 0 and 60")
+	ErrUnknownSettingsField   = errors.New("unknown settings field")
+)
+
+// Settings ルームごとの設定。
+// AutoRevealが有効な場合、オブザーバー以外の接続中の参加者が全員投票してからAutoRevealDelayが経過すると、投票を公開する。
+// Publicが有効なルームは、ListRoomsで一覧に表示する。
+type Settings struct {
+	AutoReveal      bool          `json:"auto_reveal,omitempty"`
+	AutoRevealDelay time.Duration `json:"auto_reveal_delay,omitempty"`
+	Public          bool          `json:"public,omitempty"`
+}
+
+// settingsFromProto リクエストの設定を検証して変換する。指定されていなければ全て無効にする。
+func settingsFromProto(s *pokerv1.RoomSettings) (Settings, error) {
+	if s == nil {
+		return Settings{}, nil
+	}
+	delay := time.Duration(s.AutoRevealDelaySeconds) * time.Second
+	if delay < 0 || delay > maxAutoRevealDelay {
+		return Settings{}, ErrInvalidAutoRevealDelay
+	}
+	return Settings{
+		AutoReveal:      s.AutoReveal,
+		AutoRevealDelay: delay,
+		Public:          s.Public,
+	}, nil
+}
+
+// mergeSettings currentのうち、maskに含まれる設定だけをupdateの値に変える。
+// maskが空の場合は、全ての設定をupdateで置き換える。
+func mergeSettings(current Settings, update *pokerv1.RoomSettings, mask *fieldmaskpb.FieldMask) (Settings, error) {
+	if len(mask.GetPaths()) == 0 {
+		return settingsFromProto(update)
+	}
+	merged := current.toProto()
+	for _, path := range mask.GetPaths() {
+		switch path {
+		case "auto_reveal":
+			merged.AutoReveal = update.GetAutoReveal()
+		case "auto_reveal_delay_seconds":
+			merged.AutoRevealDelaySeconds = update.GetAutoRevealDelaySeconds()
+		case "public":
+			merged.Public = update.GetPublic()
+		default:
+			return Settings{}, fmt.Errorf("%w: %s", ErrUnknownSettingsField, path)
+		}
+	}
+	return settingsFromProto(merged)
+}
+
+func (s Settings) toProto() *pokerv1.RoomSettings {
+	return &pokerv1.RoomSettings{
+		AutoReveal:             s.AutoReveal,
+		AutoRevealDelaySeconds: int32(s.AutoRevealDelay / time.Second),
+		Public:                 s.Public,
+	}
+}
+
+// allVoted 他のインスタンスに接続している参加者も含めて、オブザーバー以外の接続中の参加者が、一人以上いて全員投票しているかどうかを返す
+func (st *roomState) allVoted(record *RoomRecord) bool {
+	var voters int
+	for _, name := range record.Participants {
+		if record.roleOf(name) == pokerv1.Role_ROLE_OBSERVER {
+			continue
+		}
+		if _, ok := record.Votes[name]; !ok {
+			return false
+		}
+		voters++
+	}
+	return voters > 0
+}
+
+// checkAutoReveal 自動で公開する設定の場合に、全員が投票していれば公開を予約する。
+// 投票や参加者、ロールが変わるたびに呼び出し、予約済みの公開は待ち時間を最初からやり直す。
+// 全員の投票が揃っていなければ、予約を取り消す。
+func (st *roomState) checkAutoReveal(ctx context.Context) {
+	st.cancelAutoReveal()
+
+	record, err := st.store.GetRoom(ctx, st.id)
+	if err != nil {
+		st.logger(ctx).Error("failed to get room", "error", err)
+		return
+	}
+	// 既に公開したラウンドは、投票が変わっても自動では公開し直さない
+	if !record.Settings.AutoReveal || isCurrentRound(record) || !st.allVoted(record) {
+		return
+	}
+	if record.Settings.AutoRevealDelay == 0 {
+		st.logger(ctx).Info("every participant voted")
+		st.reveal(ctx, record)
+		return
+	}
+	// 予約したRPCは既に終わっているので、そのコンテキストやログの属性は引き継がない
+	st.pendingReveal = st.after(record.Settings.AutoRevealDelay, func(ctx context.Context, st *roomState) {
+		st.pendingReveal = nil
+		record, err := st.store.GetRoom(ctx, st.id)
+		if err != nil {
+			st.logger(ctx).Error("failed to get room", "error", err)
+			return
+		}
+		if record.Settings.AutoReveal && !isCurrentRound(record) && st.allVoted(record) {
+			st.logger(ctx).Info("every participant voted")
+			st.reveal(ctx, record)
+		}
+	})
+}
+
+// cancelAutoReveal 予約した自動での公開を取り消す
+func (st *roomState) cancelAutoReveal() {
+	if st.pendingReveal == nil {
+		return
+	}
+	close(st.pendingReveal)
+	st.pendingReveal = nil
+}
+
+// after dが経過した後に、fをルームのゴルーチンで実行する。返したチャネルを閉じると取り消せる。
+// fには、予約したRPCとは関係のないコンテキストを渡す。
+func (st *roomState) after(d time.Duration, f func(ctx context.Context, st *roomState)) chan struct{} {
+	stop := make(chan struct{})
+	r := st.room
+	go func() {
+		t := time.NewTimer(d)
+		defer t.Stop()
+		select {
+		case <-stop:
+			return
+		case <-t.C:
+		}
+		ctx := context.Background()
+		r.do(ctx, func(st *roomState) error {
+			// 依頼が届くまでの間に取り消されていれば何もしない
+			select {
+			case <-stop:
+			default:
+				f(ctx, st)
+			}
+			return nil
+		})
+	}()
+	return stop
+}
+
+func (s *pokerServer) UpdateSettings(ctx context.Context, req *connect.Request[pokerv1.UpdateSettingsRequest]) (*connect.Response[pokerv1.UpdateSettingsResponse], error) {
+	loggerFrom(ctx).Debug("UpdateSettings function was invoked")
+
+	var settings Settings
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		record, err := st.authorizeFacilitator(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+		settings, err = mergeSettings(record.Settings, req.Msg.Settings, req.Msg.UpdateMask)
+		if err != nil {
+			return connect.NewError(
+				connect.CodeInvalidArgument,
+				err,
+			)
+		}
+		if err := st.store.SaveSettings(ctx, st.id, settings); err != nil {
+			return roomNotFoundOr(st.id, err)
+		}
+		st.broadcast(newSettingsChangedEvent(settings))
+		st.checkAutoReveal(ctx)
+		st.touch(ctx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pokerv1.UpdateSettingsResponse{
+		Settings: settings.toProto(),
+	}), nil
+}
+
+func newSettingsChangedEvent(settings Settings) *pokerv1.ConnectResponse {
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_SETTINGS_CHANGED,
+		Message: strconv.FormatBool(settings.AutoReveal),
+		Event: &pokerv1.ConnectResponse_SettingsChanged{
+			SettingsChanged: &pokerv1.SettingsChanged{Settings: settings.toProto()},
+		},
+	}
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"net/http"
+
+	"google.golang.org/protobuf/encoding/protojson"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+var ErrServerShutdown = errors.New("server is shutting down")
+
+// shutdown サーバを停止する。
+// 全てのルームに停止を通知してストリームを終了させ、新しいストリームを受け付けないようにしてから、
+// ctxの期限まで処理中のRPCを待つ。最後に、再起動後に接続を再開できるよう、ルームの状態をストアに保存する。
+func (s *pokerServer) shutdown(ctx context.Context, srv *http.Server) error {
+	s.drain(ctx)
+	err := srv.Shutdown(ctx)
+	if err != nil {
+		// 期限までに終わらなかったRPCは打ち切る
+		slog.Warn("failed to wait for in-flight requests", "error", err)
+		srv.Close()
+	}
+	s.persist(context.WithoutCancel(ctx))
+	return err
+}
+
+// drain 全てのルームに停止を通知し、ストリームを終了させる。
+// この後に接続の管理を始めるルームも含め、新しいストリームはErrServerShutdownで拒否する。
+func (s *pokerServer) drain(ctx context.Context) {
+	for _, r := range s.rooms.drain() {
+		err := r.do(ctx, func(st *roomState) error {
+			st.drain(ctx)
+			return nil
+		})
+		if err != nil && !errors.Is(err, ErrRoomNotFound) {
+			slog.Error("failed to drain room", "room_id", r.id, "error", err)
+		}
+	}
+}
+
+// persist 全てのルームの状態をストアに保存し、ルームのゴルーチンを終了させる
+func (s *pokerServer) persist(ctx context.Context) {
+	for _, r := range s.rooms.all() {
+		err := r.do(ctx, func(st *roomState) error {
+			st.persist(ctx)
+			return nil
+		})
+		if err != nil && !errors.Is(err, ErrRoomNotFound) {
+			slog.Error("failed to persist room", "room_id", r.id, "error", err)
+		}
+	}
+}
+
+// drain 停止を通知した上で、ストリームを全てErrServerShutdownで終了させる。
+// ルームはストアから削除しない。接続していたクライアントが再起動後や他のインスタンスで接続を再開できるように、
+// 参加者はセッションとロールを残したままストアから外し、他のインスタンスには切断したことを知らせる。
+// ファシリテータがいなくなる場合は、他のインスタンスに残る参加者に引き継ぐ。
+func (st *roomState) drain(ctx context.Context) {
+	if st.draining {
+		return
+	}
+	st.draining = true
+	st.stopTimer()
+	st.cancelAutoReveal()
+	if len(st.streams) == 0 {
+		st.logger(ctx).Info("room is drained")
+		return
+	}
+
+	// 止まるのはこのインスタンスだけなので、他のインスタンスには配らない
+	st.deliver(newServerShuttingDownEvent())
+	for name, state := range st.streams {
+		state.cancel(ErrServerShutdown)
+		err := st.store.SuspendParticipant(ctx, st.id, name, st.origin)
+		if errors.Is(err, ErrSessionMoved) {
+			continue
+		}
+		if err != nil && !errors.Is(err, ErrRoomNotFound) {
+			st.logger(ctx).Error("failed to suspend participant", "error", err)
+		}
+		st.share(newLeaveEvent(name, pokerv1.LeaveReason_LEAVE_REASON_DISCONNECTED), true)
+	}
+	st.streams = make(map[string]StreamState)
+	st.ensureFacilitator(ctx)
+	st.logger(ctx).Info("room is drained")
+}
+
+// persist このインスタンスで切断した参加者が接続を再開できるように、シーケンス番号とイベントログをストアに保存して、ゴルーチンを終了させる。
+// 他のインスタンスが保存したイベントログは残す。
+func (st *roomState) persist(ctx context.Context) {
+	st.closed = true
+	record, err := st.store.GetRoom(ctx, st.id)
+	if err != nil {
+		if !errors.Is(err, ErrRoomNotFound) {
+			st.logger(ctx).Error("failed to get room", "error", err)
+		}
+		return
+	}
+	if !record.suspendedBy(st.origin) {
+		return
+	}
+
+	state := &ResumeState{Sequence: st.seq}
+	for _, res := range st.events {
+		b, err := protojson.Marshal(res)
+		if err != nil {
+			st.logger(ctx).Error("failed to marshal event", "error", err)
+			continue
+		}
+		state.Events = append(state.Events, b)
+	}
+	if err := st.store.SaveResumeState(ctx, st.id, st.origin, state); err != nil && !errors.Is(err, ErrRoomNotFound) {
+		st.logger(ctx).Error("failed to save resume state", "error", err)
+	}
+}
+
+// restore 停止したインスタンスが保存したイベントログのうち、最も新しいものを読み込み、その続きからシーケンス番号を振るようにする。
+// そのインスタンスに接続していたクライアントには、このルームのイベントログから再送できる。
+// 読み込んだイベントログは、全てのクライアントが接続を再開するまでストアに残す。
+func (st *roomState) restore(ctx context.Context) {
+	record, err := st.store.GetRoom(ctx, st.id)
+	if err != nil {
+		return
+	}
+	var latest string
+	for instance, state := range record.ResumeLogs {
+		if latest == "" || state.Sequence > record.ResumeLogs[latest].Sequence {
+			latest = instance
+		}
+	}
+	if latest == "" {
+		return
+	}
+	state := record.ResumeLogs[latest]
+	st.seq, st.adopted = state.Sequence, latest
+	st.events, err = state.decode()
+	if err != nil {
+		// 途中が欠けたイベントログでは再送できないので、捨てて状態を送り直させる
+		st.logger(ctx).Error("failed to unmarshal event", "error", err)
+	}
+	st.logger(ctx).Info("room is restored", "sequence", st.seq, "events", len(st.events))
+}
+
+// decode 保存したイベントログを読み込む。読み込めないイベントがあった場合は、何も返さない。
+func (s *ResumeState) decode() ([]*pokerv1.ConnectResponse, error) {
+	events := make([]*pokerv1.ConnectResponse, 0, len(s.Events))
+	for _, b := range s.Events {
+		res := &pokerv1.ConnectResponse{}
+		if err := protojson.Unmarshal(b, res); err != nil {
+			return nil, err
+		}
+		events = append(events, res)
+	}
+	return events, nil
+}
+
+func newServerShuttingDownEvent() *pokerv1.ConnectResponse {
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_SERVER_SHUTTING_DOWN,
+		Message: "server is shutting down",
+		Event: &pokerv1.ConnectResponse_ServerShuttingDown{
+			ServerShuttingDown: &pokerv1.ServerShuttingDown{},
+		},
+	}
+}
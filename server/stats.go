@@ -0,0 +1,115 @@
+package main
+
+import (
+	"math"
+	"sort"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+// abstainLabels 見積もりではなく、棄権を表すカード
+var abstainLabels = map[string]bool{
+	questionCard.Label: true,
+	coffeeCard.Label:   true,
+}
+
+// computeStatistics 公開する投票の統計を計算する。
+// votesはユーザ名をキーとした投票したカードのラベル。
+// 平均や中央値などの数値の統計は値を持つカードだけから、
+// 最頻値や広がり、合意の判定は棄権以外の全ての見積もりから、デッキ上の位置を使って計算する。
+func computeStatistics(votes map[string]string, deck Deck) *pokerv1.VoteStatistics {
+	stats := &pokerv1.VoteStatistics{}
+
+	type estimate struct {
+		voter    string
+		label    string
+		position int
+	}
+	var (
+		estimates []estimate
+		values    []float64
+	)
+	for voter, label := range votes {
+		card, position, ok := deck.find(label)
+		if !ok {
+			continue
+		}
+		if abstainLabels[label] {
+			stats.Abstentions++
+			continue
+		}
+		estimates = append(estimates, estimate{voter: voter, label: label, position: position})
+		if card.Value != nil {
+			values = append(values, *card.Value)
+		}
+	}
+
+	if len(values) > 0 {
+		sort.Float64s(values)
+		var sum float64
+		for _, v := range values {
+			sum += v
+		}
+		mean := sum / float64(len(values))
+
+		var variance float64
+		for _, v := range values {
+			variance += (v - mean) * (v - mean)
+		}
+		variance /= float64(len(values))
+
+		stats.Count = int32(len(values))
+		stats.Average = float32(mean)
+		stats.Median = median(values)
+		stats.Min = values[0]
+		stats.Max = values[len(values)-1]
+		stats.StandardDeviation = math.Sqrt(variance)
+	}
+
+	if len(estimates) == 0 {
+		return stats
+	}
+
+	// 同点の場合に結果が揺れないように、位置と名前で並べておく
+	sort.Slice(estimates, func(i, j int) bool {
+		if estimates[i].position != estimates[j].position {
+			return estimates[i].position < estimates[j].position
+		}
+		return estimates[i].voter < estimates[j].voter
+	})
+	lowest := estimates[0].position
+	highest := estimates[len(estimates)-1].position
+	stats.Spread = int32(highest - lowest)
+
+	frequency := make(map[int]int, len(estimates))
+	var maxFrequency int
+	for _, e := range estimates {
+		frequency[e.position]++
+		if frequency[e.position] > maxFrequency {
+			maxFrequency = frequency[e.position]
+		}
+		if e.position == lowest {
+			stats.LowestVoters = append(stats.LowestVoters, e.voter)
+		}
+		if e.position == highest {
+			stats.HighestVoters = append(stats.HighestVoters, e.voter)
+		}
+	}
+	for i, e := range estimates {
+		if frequency[e.position] == maxFrequency && (i == 0 || estimates[i-1].position != e.position) {
+			stats.Modes = append(stats.Modes, e.label)
+		}
+	}
+
+	stats.Consensus = len(votes) > 1 && stats.Abstentions == 0 && stats.Spread == 0
+	return stats
+}
+
+// median ソート済みのvaluesの中央値を返す
+func median(values []float64) float64 {
+	n := len(values)
+	if n%2 == 1 {
+		return values[n/2]
+	}
+	return (values[n/2-1] + values[n/2]) / 2
+}
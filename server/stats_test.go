@@ -0,0 +1,103 @@
+package main
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+func TestComputeStatistics(t *testing.T) {
+	fibonacci := defaultDeck()
+	tShirt := Deck{
+		Preset: pokerv1.DeckPreset_DECK_PRESET_T_SHIRT,
+		Cards:  presetDecks[pokerv1.DeckPreset_DECK_PRESET_T_SHIRT],
+	}
+
+	tests := []struct {
+		name  string
+		votes map[string]string
+		deck  Deck
+		want  *pokerv1.VoteStatistics
+	}{
+		{
+			name:  "no votes",
+			votes: map[string]string{},
+			deck:  fibonacci,
+			want:  &pokerv1.VoteStatistics{},
+		},
+		{
+			name:  "zero is a vote",
+			votes: map[string]string{"Taro": "0", "Hanako": "0"},
+			deck:  fibonacci,
+			want: &pokerv1.VoteStatistics{
+				Count:         2,
+				Modes:         []string{"0"},
+				Consensus:     true,
+				LowestVoters:  []string{"Hanako", "Taro"},
+				HighestVoters: []string{"Hanako", "Taro"},
+			},
+		},
+		{
+			name:  "spread and outliers",
+			votes: map[string]string{"Taro": "2", "Hanako": "3", "Jiro": "3", "Saburo": "13", "Shiro": "?"},
+			deck:  fibonacci,
+			want: &pokerv1.VoteStatistics{
+				Average:           5.25,
+				Count:             4,
+				Median:            3,
+				Modes:             []string{"3"},
+				Min:               2,
+				Max:               13,
+				StandardDeviation: math.Sqrt(((2-5.25)*(2-5.25) + 2*(3-5.25)*(3-5.25) + (13-5.25)*(13-5.25)) / 4),
+				Spread:            4,
+				LowestVoters:      []string{"Taro"},
+				HighestVoters:     []string{"Saburo"},
+				Abstentions:       1,
+			},
+		},
+		{
+			name:  "multiple modes",
+			votes: map[string]string{"Taro": "5", "Hanako": "8"},
+			deck:  fibonacci,
+			want: &pokerv1.VoteStatistics{
+				Average:           6.5,
+				Count:             2,
+				Median:            6.5,
+				Modes:             []string{"5", "8"},
+				Min:               5,
+				Max:               8,
+				StandardDeviation: 1.5,
+				Spread:            1,
+				LowestVoters:      []string{"Taro"},
+				HighestVoters:     []string{"Hanako"},
+			},
+		},
+		{
+			name:  "non-numeric deck",
+			votes: map[string]string{"Taro": "S", "Hanako": "XL", "Jiro": "S", "Saburo": "☕"},
+			deck:  tShirt,
+			want: &pokerv1.VoteStatistics{
+				Modes:         []string{"S"},
+				Spread:        3,
+				LowestVoters:  []string{"Jiro", "Taro"},
+				HighestVoters: []string{"Hanako"},
+				Abstentions:   1,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := computeStatistics(tt.votes, tt.deck)
+			if math.Abs(got.StandardDeviation-tt.want.StandardDeviation) > 1e-9 {
+				t.Errorf("standard deviation = %v, want %v", got.StandardDeviation, tt.want.StandardDeviation)
+			}
+			got.StandardDeviation = tt.want.StandardDeviation
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("got %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
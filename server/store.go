@@ -0,0 +1,326 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"maps"
+	"slices"
+	"time"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+var (
+	ErrRoomNotFound = errors.New("room not found")
+	ErrSessionMoved = errors.New("session was moved to another instance")
+)
+
+// RoomRecord ストアに保存されるルームの状態。
+// クライアントとのストリームは永続化できないため、roomStateとは別に管理する。
+// Votesは参加者のIDをキーとして、投票したカードのラベルを保持する。
+// Rolesは参加者のIDをキーとしたロール。含まれない参加者はROLE_VOTERとして扱う。退出するか外されるまでは、切断されても残す。
+// Storiesは見積もるストーリーを並べた順に、CurrentStoryは現在見積もっているストーリーのIDを保持する。
+// Roundsは投票を公開したラウンドを古い順に、RoundStartedAtは現在のラウンドを始めた時刻を保持する。
+// InviteCodeはハイフンを除いた招待コードで、ルームごとに一意。
+// PassphraseHashは参加に必要な合言葉のbcryptのハッシュで、合言葉がなければ空。
+// Lockedが真の間は、新しい参加者を受け入れない。
+// Sessionsは参加者のIDをキーとした、参加者に発行したセッション。どのインスタンスでもリクエストを認証できるように、ストアで共有する。
+// 切断された参加者のセッションは、トークンを示して接続を再開できるように、参加者から外した後も残す。
+// ResumeLogsは、停止したインスタンスのIDをキーとした、停止した時点のイベントログ。どのセッションからも参照されなくなったものは消す。
+// Timerは現在のラウンドで動いているタイマーで、なければnil。
+type RoomRecord struct {
+	ID             string                  `json:"id"`
+	InviteCode     string                  `json:"invite_code,omitempty"`
+	PassphraseHash string                  `json:"passphrase_hash,omitempty"`
+	Locked         bool                    `json:"locked,omitempty"`
+	Deck           Deck                    `json:"deck"`
+	Settings       Settings                `json:"settings"`
+	Participants   []string                `json:"participants"`
+	Votes          map[string]string       `json:"votes"`
+	Roles          map[string]pokerv1.Role `json:"roles"`
+	Sessions       map[string]Session      `json:"sessions,omitempty"`
+	Stories        []Story                 `json:"stories,omitempty"`
+	CurrentStory   string                  `json:"current_story,omitempty"`
+	Rounds         []Round                 `json:"rounds,omitempty"`
+	RoundStartedAt time.Time               `json:"round_started_at"`
+	CreatedAt      time.Time               `json:"created_at"`
+	LastUsedAt     time.Time               `json:"last_used_at"`
+	ResumeLogs     map[string]*ResumeState `json:"resume_logs,omitempty"`
+	Timer          *RoundTimer             `json:"timer,omitempty"`
+}
+
+// Session 参加者のストリームに発行したセッション。
+// TokenHashはセッショントークンのハッシュ、Instanceはストリームを受け持つインスタンスのID。
+// ExpiresAtは、ストリームを受け持つインスタンスが動いていることを確かめられる期限で、そのインスタンスが定期的に延ばす。
+// 期限が過ぎたセッションの参加者は、インスタンスが退出させずに落ちたものとして、他のインスタンスが外す。
+type Session struct {
+	TokenHash string    `json:"token_hash"`
+	Instance  string    `json:"instance"`
+	ExpiresAt time.Time `json:"expires_at"`
+}
+
+// RoundTimer 現在のラウンドのタイマー。どのインスタンスでも残り時間を求めたり、期限切れを処理したりできるように、ストアで共有する。
+// IDは開始するたびに振り直し、期限切れを処理するインスタンスを一つに決めるために使う。
+type RoundTimer struct {
+	ID         string        `json:"id"`
+	Deadline   time.Time     `json:"deadline"`
+	Duration   time.Duration `json:"duration"`
+	AutoReveal bool          `json:"auto_reveal,omitempty"`
+}
+
+// ResumeState 停止したインスタンスに接続していたクライアントが、再起動後のインスタンスや他のインスタンスで接続を再開するための状態。
+// Sequenceは最後に振ったシーケンス番号、EventsはprotojsonにしたConnectResponseのイベントログ。
+type ResumeState struct {
+	Sequence uint64            `json:"sequence"`
+	Events   []json.RawMessage `json:"events,omitempty"`
+}
+
+func (s *ResumeState) clone() *ResumeState {
+	if s == nil {
+		return nil
+	}
+	c := *s
+	c.Events = append([]json.RawMessage(nil), s.Events...)
+	return &c
+}
+
+func (r *RoomRecord) clone() *RoomRecord {
+	c := *r
+	c.Participants = append([]string(nil), r.Participants...)
+	c.Stories = append([]Story(nil), r.Stories...)
+	c.Rounds = cloneRounds(r.Rounds)
+	if r.ResumeLogs != nil {
+		c.ResumeLogs = make(map[string]*ResumeState, len(r.ResumeLogs))
+		for k, v := range r.ResumeLogs {
+			c.ResumeLogs[k] = v.clone()
+		}
+	}
+	c.Votes = make(map[string]string, len(r.Votes))
+	for k, v := range r.Votes {
+		c.Votes[k] = v
+	}
+	c.Roles = make(map[string]pokerv1.Role, len(r.Roles))
+	for k, v := range r.Roles {
+		c.Roles[k] = v
+	}
+	c.Sessions = maps.Clone(r.Sessions)
+	if r.Timer != nil {
+		t := *r.Timer
+		c.Timer = &t
+	}
+	return &c
+}
+
+// RoomStore ルームと投票の状態を保存するストア。
+// 接続中のルームの状態は、そのルームのゴルーチンがこのインターフェースを通して読み書きする。
+// 存在しないルームを操作した場合はErrRoomNotFoundを、
+// 既に存在するIDでルームを作成した場合はErrExistRoomを、
+// 他のルームと同じ招待コードでルームを作成した場合はErrExistInviteCodeを返す。
+type RoomStore interface {
+	CreateRoom(ctx context.Context, room *RoomRecord) error
+	GetRoom(ctx context.Context, roomId string) (*RoomRecord, error)
+	FindRoomByInviteCode(ctx context.Context, inviteCode string) (*RoomRecord, error)
+	DeleteRoom(ctx context.Context, roomId string) error
+	ListRooms(ctx context.Context) ([]*RoomRecord, error)
+
+	PutVote(ctx context.Context, roomId, participant, card string) error
+	ClearVote(ctx context.Context, roomId, participant string) error
+	// StartRound 全ての投票を消し、startedAtに新しいラウンドを始める
+	StartRound(ctx context.Context, roomId string, startedAt time.Time) error
+	// SaveRounds 公開したラウンドの履歴を置き換える
+	SaveRounds(ctx context.Context, roomId string, rounds []Round) error
+
+	// AddParticipant participantを参加者に加え、ストリームに発行したsessionを記録する。既に参加している場合はsessionを置き換える
+	AddParticipant(ctx context.Context, roomId, participant string, session Session) error
+	// RemoveParticipant participantを参加者から外し、セッションとロールも消す。同じ名前で参加し直した場合は、新しい参加者として扱う
+	RemoveParticipant(ctx context.Context, roomId, participant string) error
+	// SuspendParticipant instanceが受け持っていたparticipantのストリームが切断された際に、参加者から外すが、接続を再開できるようにセッションとロールは残す。
+	// 既に他のインスタンスで接続を再開している場合は、何もせずにErrSessionMovedを返す
+	SuspendParticipant(ctx context.Context, roomId, participant, instance string) error
+	// RefreshSessions instanceが受け持つ参加者のセッションの期限を、expiresAtまで延ばす
+	RefreshSessions(ctx context.Context, roomId, instance string, expiresAt time.Time) error
+	// ExpireSessions nowまでに期限を延ばされなかったセッションの参加者を、SuspendParticipantと同様に外し、外した参加者を返す
+	ExpireSessions(ctx context.Context, roomId string, now time.Time) ([]string, error)
+
+	// StartTimer 現在のラウンドのタイマーをtimerにする。動いているタイマーは置き換える
+	StartTimer(ctx context.Context, roomId string, timer *RoundTimer) error
+	// StopTimer 動いているタイマーがidのものであれば消す。消したかどうかを返す
+	StopTimer(ctx context.Context, roomId, id string) (bool, error)
+
+	SetRole(ctx context.Context, roomId, participant string, role pokerv1.Role) error
+	SaveSettings(ctx context.Context, roomId string, settings Settings) error
+	SetLocked(ctx context.Context, roomId string, locked bool) error
+	// SaveResumeState instanceに接続していたクライアントが接続を再開するための状態を保存する。
+	// 他のインスタンスが保存した状態は残す。nilの場合はinstanceの状態を消す
+	SaveResumeState(ctx context.Context, roomId, instance string, state *ResumeState) error
+
+	// SaveStories ストーリーの一覧と現在のストーリーをまとめて置き換える
+	SaveStories(ctx context.Context, roomId string, stories []Story, current string) error
+
+	Touch(ctx context.Context, roomId string, usedAt time.Time) error
+
+	// Ping ルームを読み書きできる状態かを確かめる
+	Ping(ctx context.Context) error
+
+	Close() error
+}
+
+// names 接続中の参加者のIDを名前順に返す。
+// 他のインスタンスに接続している参加者も含めるため、ストリームではなくストアに記録された参加者から求める。
+func (r *RoomRecord) names() []string {
+	names := slices.Clone(r.Participants)
+	slices.Sort(names)
+	return names
+}
+
+// hasParticipant participantがルームに参加したことがあり、まだ退出していないかどうかを返す
+func (r *RoomRecord) hasParticipant(participant string) bool {
+	for _, p := range r.Participants {
+		if p == participant {
+			return true
+		}
+	}
+	return false
+}
+
+// addParticipant participantを参加者に加え、sessionを記録する
+func (r *RoomRecord) addParticipant(participant string, session Session) {
+	if !r.hasParticipant(participant) {
+		r.Participants = append(r.Participants, participant)
+	}
+	if r.Sessions == nil {
+		r.Sessions = make(map[string]Session)
+	}
+	r.Sessions[participant] = session
+	r.pruneResumeLogs()
+}
+
+// removeParticipant participantを参加者から外し、セッションとロールも消す
+func (r *RoomRecord) removeParticipant(participant string) {
+	delete(r.Roles, participant)
+	delete(r.Sessions, participant)
+	r.Participants = slices.DeleteFunc(r.Participants, func(p string) bool { return p == participant })
+	r.pruneResumeLogs()
+}
+
+// suspendParticipant instanceが受け持っていたparticipantを、セッションとロールを残して外す。
+// 他のインスタンスに移っている場合はErrSessionMovedを返す
+func (r *RoomRecord) suspendParticipant(participant, instance string) error {
+	if session, ok := r.Sessions[participant]; ok && session.Instance != instance {
+		return ErrSessionMoved
+	}
+	r.Participants = slices.DeleteFunc(r.Participants, func(p string) bool { return p == participant })
+	return nil
+}
+
+// saveResumeState instanceの接続を再開するための状態を保存する。nilの場合は消す
+func (r *RoomRecord) saveResumeState(instance string, state *ResumeState) {
+	if state == nil {
+		delete(r.ResumeLogs, instance)
+		return
+	}
+	if r.ResumeLogs == nil {
+		r.ResumeLogs = make(map[string]*ResumeState)
+	}
+	r.ResumeLogs[instance] = state
+}
+
+// pruneResumeLogs どのセッションからも参照されなくなった、停止したインスタンスのイベントログを消す
+func (r *RoomRecord) pruneResumeLogs() {
+	for instance := range r.ResumeLogs {
+		referenced := false
+		for _, session := range r.Sessions {
+			if session.Instance == instance {
+				referenced = true
+				break
+			}
+		}
+		if !referenced {
+			delete(r.ResumeLogs, instance)
+		}
+	}
+}
+
+// refreshSessions instanceが受け持つ参加者のセッションの期限を、expiresAtまで延ばす
+func (r *RoomRecord) refreshSessions(instance string, expiresAt time.Time) {
+	for _, p := range r.Participants {
+		if session, ok := r.Sessions[p]; ok && session.Instance == instance {
+			session.ExpiresAt = expiresAt
+			r.Sessions[p] = session
+		}
+	}
+}
+
+// expiredParticipants nowまでにセッションの期限を延ばされなかった参加者を返す。
+// セッションが記録されていない参加者は、期限を延ばすインスタンスがいないので含める。
+func (r *RoomRecord) expiredParticipants(now time.Time) []string {
+	var expired []string
+	for _, p := range r.Participants {
+		if session, ok := r.Sessions[p]; !ok || session.ExpiresAt.Before(now) {
+			expired = append(expired, p)
+		}
+	}
+	return expired
+}
+
+// expireSessions expiredParticipantsの参加者を、セッションとロールを残して外し、外した参加者を返す
+func (r *RoomRecord) expireSessions(now time.Time) []string {
+	expired := r.expiredParticipants(now)
+	r.Participants = slices.DeleteFunc(r.Participants, func(p string) bool { return slices.Contains(expired, p) })
+	return expired
+}
+
+// stopTimer 動いているタイマーがidのものであれば消し、消したかどうかを返す
+func (r *RoomRecord) stopTimer(id string) bool {
+	if r.Timer == nil || r.Timer.ID != id {
+		return false
+	}
+	r.Timer = nil
+	return true
+}
+
+// setRole participantのロールをroleにする
+func (r *RoomRecord) setRole(participant string, role pokerv1.Role) {
+	if r.Roles == nil {
+		r.Roles = make(map[string]pokerv1.Role)
+	}
+	r.Roles[participant] = role
+}
+
+// initTimes 作成する際に指定されなかった時刻を、作成した時刻で埋める
+func (r *RoomRecord) initTimes() {
+	if r.CreatedAt.IsZero() {
+		r.CreatedAt = time.Now()
+	}
+	if r.LastUsedAt.IsZero() {
+		r.LastUsedAt = r.CreatedAt
+	}
+	if r.RoundStartedAt.IsZero() {
+		r.RoundStartedAt = r.CreatedAt
+	}
+}
+
+// authenticate tokenが、接続中のparticipantに発行したセッショントークンかどうかを返す
+func (r *RoomRecord) authenticate(participant, token string) bool {
+	session, ok := r.Sessions[participant]
+	return ok && token != "" && r.hasParticipant(participant) && sameToken(hashToken(token), session.TokenHash)
+}
+
+// suspendedBy instanceで切断され、まだ接続を再開していない参加者がいるかどうかを返す
+func (r *RoomRecord) suspendedBy(instance string) bool {
+	for p, session := range r.Sessions {
+		if session.Instance == instance && !r.hasParticipant(p) {
+			return true
+		}
+	}
+	return false
+}
+
+// roleOf participantのロールを返す
+func (r *RoomRecord) roleOf(participant string) pokerv1.Role {
+	if role, ok := r.Roles[participant]; ok {
+		return role
+	}
+	return pokerv1.Role_ROLE_VOTER
+}
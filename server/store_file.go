@@ -0,0 +1,291 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	"go.etcd.io/bbolt"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+// fileRoomBucket ルームのレコードを保存するバケット
+var fileRoomBucket = []byte("rooms")
+
+// fileRoomLocks ルームのレコードの書き出しの順序を揃えるためのロックの数
+const fileRoomLocks = 64
+
+// fileRoomStore ルームの状態をbboltのファイルに永続化するRoomStore。
+// ルームごとにJSONのレコードを一つ保存し、状態を変更したルームのレコードだけを書き換える。
+// 読み込みはメモリ上のコピーから行う。
+// 別々のルームの書き込みは一つのトランザクションにまとめてfsyncするので、ルームのゴルーチン同士が書き出しを待ち合わない。
+// Touchによる最終利用日時の更新はファイルに書き出さず、そのルームの次の書き込みかCloseの際に一緒に書き出す。
+type fileRoomStore struct {
+	db  *bbolt.DB
+	mem *memoryRoomStore
+	// locks 同じルームへの書き込みを、メモリ上で更新した順にファイルへ書き出すためのロック。ルームのIDのハッシュで選ぶ
+	locks [fileRoomLocks]sync.Mutex
+
+	mu      sync.Mutex
+	touched map[string]struct{}
+}
+
+// NewFileRoomStore pathのファイルからルームを読み込んだRoomStoreを返す。
+// ファイルが存在しない場合は作成する。
+func NewFileRoomStore(path string) (*fileRoomStore, error) {
+	// 他のプロセスが開いている場合は、ロックが解放されるのを待たずにエラーにする
+	db, err := bbolt.Open(path, 0o600, &bbolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("failed to open room store %s: %w", path, err)
+	}
+	s := &fileRoomStore{
+		db:      db,
+		mem:     NewMemoryRoomStore(),
+		touched: make(map[string]struct{}),
+	}
+
+	err = db.Update(func(tx *bbolt.Tx) error {
+		b, err := tx.CreateBucketIfNotExists(fileRoomBucket)
+		if err != nil {
+			return err
+		}
+		return b.ForEach(func(k, v []byte) error {
+			var r RoomRecord
+			if err := json.Unmarshal(v, &r); err != nil {
+				return fmt.Errorf("failed to parse room %s: %w", k, err)
+			}
+			// ストリームはサーバの再起動を跨げないので、参加者は再接続時に登録し直してもらう
+			r.Participants = nil
+			if r.Votes == nil {
+				r.Votes = make(map[string]string)
+			}
+			if len(r.Deck.Cards) == 0 {
+				r.Deck = defaultDeck()
+			}
+			if r.RoundStartedAt.IsZero() {
+				r.RoundStartedAt = r.CreatedAt
+			}
+			s.mem.put(&r)
+			return nil
+		})
+	})
+	if err != nil {
+		db.Close()
+		return nil, fmt.Errorf("failed to read room store %s: %w", path, err)
+	}
+	return s, nil
+}
+
+func (s *fileRoomStore) CreateRoom(ctx context.Context, room *RoomRecord) error {
+	return s.write(ctx, room.ID, func() error { return s.mem.CreateRoom(ctx, room) })
+}
+
+func (s *fileRoomStore) GetRoom(ctx context.Context, roomId string) (*RoomRecord, error) {
+	return s.mem.GetRoom(ctx, roomId)
+}
+
+func (s *fileRoomStore) FindRoomByInviteCode(ctx context.Context, inviteCode string) (*RoomRecord, error) {
+	return s.mem.FindRoomByInviteCode(ctx, inviteCode)
+}
+
+func (s *fileRoomStore) DeleteRoom(ctx context.Context, roomId string) error {
+	return s.write(ctx, roomId, func() error { return s.mem.DeleteRoom(ctx, roomId) })
+}
+
+func (s *fileRoomStore) ListRooms(ctx context.Context) ([]*RoomRecord, error) {
+	return s.mem.ListRooms(ctx)
+}
+
+func (s *fileRoomStore) PutVote(ctx context.Context, roomId, participant, card string) error {
+	return s.write(ctx, roomId, func() error { return s.mem.PutVote(ctx, roomId, participant, card) })
+}
+
+func (s *fileRoomStore) ClearVote(ctx context.Context, roomId, participant string) error {
+	return s.write(ctx, roomId, func() error { return s.mem.ClearVote(ctx, roomId, participant) })
+}
+
+func (s *fileRoomStore) StartRound(ctx context.Context, roomId string, startedAt time.Time) error {
+	return s.write(ctx, roomId, func() error { return s.mem.StartRound(ctx, roomId, startedAt) })
+}
+
+func (s *fileRoomStore) SaveRounds(ctx context.Context, roomId string, rounds []Round) error {
+	return s.write(ctx, roomId, func() error { return s.mem.SaveRounds(ctx, roomId, rounds) })
+}
+
+func (s *fileRoomStore) AddParticipant(ctx context.Context, roomId, participant string, session Session) error {
+	return s.write(ctx, roomId, func() error { return s.mem.AddParticipant(ctx, roomId, participant, session) })
+}
+
+func (s *fileRoomStore) RemoveParticipant(ctx context.Context, roomId, participant string) error {
+	return s.write(ctx, roomId, func() error { return s.mem.RemoveParticipant(ctx, roomId, participant) })
+}
+
+func (s *fileRoomStore) StartTimer(ctx context.Context, roomId string, timer *RoundTimer) error {
+	return s.write(ctx, roomId, func() error { return s.mem.StartTimer(ctx, roomId, timer) })
+}
+
+func (s *fileRoomStore) StopTimer(ctx context.Context, roomId, id string) (bool, error) {
+	var stopped bool
+	err := s.write(ctx, roomId, func() error {
+		var err error
+		stopped, err = s.mem.StopTimer(ctx, roomId, id)
+		return err
+	})
+	return stopped, err
+}
+
+func (s *fileRoomStore) SuspendParticipant(ctx context.Context, roomId, participant, instance string) error {
+	return s.write(ctx, roomId, func() error { return s.mem.SuspendParticipant(ctx, roomId, participant, instance) })
+}
+
+// RefreshSessions Touchと同様に、メモリ上のセッションの期限だけを延ばす。
+// ファイルを使うのは一つのインスタンスだけなので、ファイルに残った期限は、再起動した際に前のプロセスの参加者を外すためにだけ使う。
+func (s *fileRoomStore) RefreshSessions(ctx context.Context, roomId, instance string, expiresAt time.Time) error {
+	if err := s.mem.RefreshSessions(ctx, roomId, instance, expiresAt); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.touched[roomId] = struct{}{}
+	s.mu.Unlock()
+	return nil
+}
+
+func (s *fileRoomStore) ExpireSessions(ctx context.Context, roomId string, now time.Time) ([]string, error) {
+	var expired []string
+	err := s.write(ctx, roomId, func() error {
+		var err error
+		expired, err = s.mem.ExpireSessions(ctx, roomId, now)
+		return err
+	})
+	return expired, err
+}
+
+func (s *fileRoomStore) SetRole(ctx context.Context, roomId, participant string, role pokerv1.Role) error {
+	return s.write(ctx, roomId, func() error { return s.mem.SetRole(ctx, roomId, participant, role) })
+}
+
+func (s *fileRoomStore) SaveSettings(ctx context.Context, roomId string, settings Settings) error {
+	return s.write(ctx, roomId, func() error { return s.mem.SaveSettings(ctx, roomId, settings) })
+}
+
+func (s *fileRoomStore) SaveResumeState(ctx context.Context, roomId, instance string, state *ResumeState) error {
+	return s.write(ctx, roomId, func() error { return s.mem.SaveResumeState(ctx, roomId, instance, state) })
+}
+
+func (s *fileRoomStore) SetLocked(ctx context.Context, roomId string, locked bool) error {
+	return s.write(ctx, roomId, func() error { return s.mem.SetLocked(ctx, roomId, locked) })
+}
+
+func (s *fileRoomStore) SaveStories(ctx context.Context, roomId string, stories []Story, current string) error {
+	return s.write(ctx, roomId, func() error { return s.mem.SaveStories(ctx, roomId, stories, current) })
+}
+
+// Touch メモリ上の最終利用日時だけを更新する。
+// 多くのRPCから呼ばれるので、毎回fsyncしないように、ファイルへはそのルームの次の書き込みと一緒に書き出す。
+func (s *fileRoomStore) Touch(ctx context.Context, roomId string, usedAt time.Time) error {
+	if err := s.mem.Touch(ctx, roomId, usedAt); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	s.touched[roomId] = struct{}{}
+	s.mu.Unlock()
+	return nil
+}
+
+// Ping ファイルを読み出せる状態かを確かめる
+func (s *fileRoomStore) Ping(_ context.Context) error {
+	return s.db.View(func(tx *bbolt.Tx) error {
+		if tx.Bucket(fileRoomBucket) == nil {
+			return errors.New("room bucket does not exist")
+		}
+		return nil
+	})
+}
+
+// Close まだ書き出していない最終利用日時を書き出してからファイルを閉じる
+func (s *fileRoomStore) Close() error {
+	s.mu.Lock()
+	touched := s.touched
+	s.touched = make(map[string]struct{})
+	s.mu.Unlock()
+
+	err := s.db.Update(func(tx *bbolt.Tx) error {
+		for roomId := range touched {
+			r, err := s.mem.GetRoom(context.Background(), roomId)
+			if errors.Is(err, ErrRoomNotFound) {
+				continue
+			}
+			if err != nil {
+				return err
+			}
+			if err := putFileRoom(tx, r); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	return errors.Join(err, s.db.Close())
+}
+
+// write fでメモリ上の状態を更新し、成功した場合はroomIdのルームのレコードを書き出す。
+// 書き出しは他のルームの書き込みとまとめて一つのトランザクションで行う。
+// 書き出せなかった場合は、メモリ上の状態もfを呼ぶ前に戻す。
+func (s *fileRoomStore) write(ctx context.Context, roomId string, f func() error) error {
+	lock := s.lock(roomId)
+	lock.Lock()
+	defer lock.Unlock()
+
+	prev, err := s.mem.GetRoom(ctx, roomId)
+	if errors.Is(err, ErrRoomNotFound) {
+		prev = nil
+	} else if err != nil {
+		return err
+	}
+	if err := f(); err != nil {
+		return err
+	}
+	s.mu.Lock()
+	delete(s.touched, roomId)
+	s.mu.Unlock()
+
+	r, err := s.mem.GetRoom(ctx, roomId)
+	if errors.Is(err, ErrRoomNotFound) {
+		r = nil
+	} else if err != nil {
+		return err
+	}
+	err = s.db.Batch(func(tx *bbolt.Tx) error {
+		if r == nil {
+			return tx.Bucket(fileRoomBucket).Delete([]byte(roomId))
+		}
+		return putFileRoom(tx, r)
+	})
+	if err != nil {
+		s.mem.replace(roomId, prev)
+		s.mu.Lock()
+		s.touched[roomId] = struct{}{}
+		s.mu.Unlock()
+		return fmt.Errorf("failed to write room %s: %w", roomId, err)
+	}
+	return nil
+}
+
+// lock roomIdのルームへの書き込みに使うロックを返す
+func (s *fileRoomStore) lock(roomId string) *sync.Mutex {
+	h := fnv.New32a()
+	h.Write([]byte(roomId))
+	return &s.locks[h.Sum32()%fileRoomLocks]
+}
+
+func putFileRoom(tx *bbolt.Tx, r *RoomRecord) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to marshal room: %w", err)
+	}
+	return tx.Bucket(fileRoomBucket).Put([]byte(r.ID), b)
+}
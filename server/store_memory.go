@@ -0,0 +1,248 @@
+package main
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+// memoryRoomStore ルームの状態をメモリ上に保持するRoomStore。
+// サーバを再起動すると全てのルームが失われる。
+// invitesは招待コードからルームのIDを引くための索引。
+type memoryRoomStore struct {
+	mu      sync.Mutex
+	rooms   map[string]*RoomRecord
+	invites map[string]string
+}
+
+func NewMemoryRoomStore() *memoryRoomStore {
+	return &memoryRoomStore{
+		rooms:   make(map[string]*RoomRecord),
+		invites: make(map[string]string),
+	}
+}
+
+func (s *memoryRoomStore) CreateRoom(_ context.Context, room *RoomRecord) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if _, ok := s.rooms[room.ID]; ok {
+		return ErrExistRoom
+	}
+	if _, ok := s.invites[room.InviteCode]; ok && room.InviteCode != "" {
+		return ErrExistInviteCode
+	}
+	r := room.clone()
+	r.initTimes()
+	s.put(r)
+	return nil
+}
+
+// put rをそのまま保持し、招待コードの索引に登録する
+func (s *memoryRoomStore) put(r *RoomRecord) {
+	s.rooms[r.ID] = r
+	if r.InviteCode != "" {
+		s.invites[r.InviteCode] = r.ID
+	}
+}
+
+// replace roomIdのルームをrに置き換える。rがnilの場合はルームを削除する
+func (s *memoryRoomStore) replace(roomId string, r *RoomRecord) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if cur, ok := s.rooms[roomId]; ok {
+		delete(s.invites, cur.InviteCode)
+		delete(s.rooms, roomId)
+	}
+	if r != nil {
+		s.put(r)
+	}
+}
+
+func (s *memoryRoomStore) GetRoom(_ context.Context, roomId string) (*RoomRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.rooms[roomId]
+	if !ok {
+		return nil, ErrRoomNotFound
+	}
+	return r.clone(), nil
+}
+
+func (s *memoryRoomStore) FindRoomByInviteCode(_ context.Context, inviteCode string) (*RoomRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	id, ok := s.invites[inviteCode]
+	if !ok || inviteCode == "" {
+		return nil, ErrRoomNotFound
+	}
+	return s.rooms[id].clone(), nil
+}
+
+func (s *memoryRoomStore) DeleteRoom(_ context.Context, roomId string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.rooms[roomId]
+	if !ok {
+		return ErrRoomNotFound
+	}
+	delete(s.invites, r.InviteCode)
+	delete(s.rooms, roomId)
+	return nil
+}
+
+func (s *memoryRoomStore) ListRooms(_ context.Context) ([]*RoomRecord, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	rooms := make([]*RoomRecord, 0, len(s.rooms))
+	for _, r := range s.rooms {
+		rooms = append(rooms, r.clone())
+	}
+	sort.Slice(rooms, func(i, j int) bool { return rooms[i].ID < rooms[j].ID })
+	return rooms, nil
+}
+
+func (s *memoryRoomStore) PutVote(_ context.Context, roomId, participant, card string) error {
+	return s.update(roomId, func(r *RoomRecord) {
+		r.Votes[participant] = card
+	})
+}
+
+func (s *memoryRoomStore) ClearVote(_ context.Context, roomId, participant string) error {
+	return s.update(roomId, func(r *RoomRecord) {
+		delete(r.Votes, participant)
+	})
+}
+
+func (s *memoryRoomStore) StartRound(_ context.Context, roomId string, startedAt time.Time) error {
+	return s.update(roomId, func(r *RoomRecord) {
+		r.Votes = make(map[string]string)
+		r.RoundStartedAt = startedAt
+	})
+}
+
+func (s *memoryRoomStore) SaveRounds(_ context.Context, roomId string, rounds []Round) error {
+	return s.update(roomId, func(r *RoomRecord) {
+		r.Rounds = cloneRounds(rounds)
+	})
+}
+
+func (s *memoryRoomStore) AddParticipant(_ context.Context, roomId, participant string, session Session) error {
+	return s.update(roomId, func(r *RoomRecord) {
+		r.addParticipant(participant, session)
+	})
+}
+
+func (s *memoryRoomStore) RemoveParticipant(_ context.Context, roomId, participant string) error {
+	return s.update(roomId, func(r *RoomRecord) {
+		r.removeParticipant(participant)
+	})
+}
+
+func (s *memoryRoomStore) StartTimer(_ context.Context, roomId string, timer *RoundTimer) error {
+	t := *timer
+	return s.update(roomId, func(r *RoomRecord) {
+		r.Timer = &t
+	})
+}
+
+func (s *memoryRoomStore) StopTimer(_ context.Context, roomId, id string) (bool, error) {
+	var stopped bool
+	err := s.update(roomId, func(r *RoomRecord) {
+		stopped = r.stopTimer(id)
+	})
+	return stopped, err
+}
+
+func (s *memoryRoomStore) SuspendParticipant(_ context.Context, roomId, participant, instance string) error {
+	var moved error
+	err := s.update(roomId, func(r *RoomRecord) {
+		moved = r.suspendParticipant(participant, instance)
+	})
+	if err != nil {
+		return err
+	}
+	return moved
+}
+
+func (s *memoryRoomStore) RefreshSessions(_ context.Context, roomId, instance string, expiresAt time.Time) error {
+	return s.update(roomId, func(r *RoomRecord) {
+		r.refreshSessions(instance, expiresAt)
+	})
+}
+
+func (s *memoryRoomStore) ExpireSessions(_ context.Context, roomId string, now time.Time) ([]string, error) {
+	var expired []string
+	err := s.update(roomId, func(r *RoomRecord) {
+		expired = r.expireSessions(now)
+	})
+	return expired, err
+}
+
+func (s *memoryRoomStore) SetRole(_ context.Context, roomId, participant string, role pokerv1.Role) error {
+	return s.update(roomId, func(r *RoomRecord) {
+		r.setRole(participant, role)
+	})
+}
+
+func (s *memoryRoomStore) SaveSettings(_ context.Context, roomId string, settings Settings) error {
+	return s.update(roomId, func(r *RoomRecord) {
+		r.Settings = settings
+	})
+}
+
+func (s *memoryRoomStore) SaveResumeState(_ context.Context, roomId, instance string, state *ResumeState) error {
+	return s.update(roomId, func(r *RoomRecord) {
+		r.saveResumeState(instance, state.clone())
+	})
+}
+
+func (s *memoryRoomStore) SetLocked(_ context.Context, roomId string, locked bool) error {
+	return s.update(roomId, func(r *RoomRecord) {
+		r.Locked = locked
+	})
+}
+
+func (s *memoryRoomStore) SaveStories(_ context.Context, roomId string, stories []Story, current string) error {
+	return s.update(roomId, func(r *RoomRecord) {
+		r.Stories = append([]Story(nil), stories...)
+		r.CurrentStory = current
+	})
+}
+
+func (s *memoryRoomStore) Touch(_ context.Context, roomId string, usedAt time.Time) error {
+	return s.update(roomId, func(r *RoomRecord) {
+		r.LastUsedAt = usedAt
+	})
+}
+
+func (s *memoryRoomStore) Ping(_ context.Context) error {
+	return nil
+}
+
+func (s *memoryRoomStore) Close() error {
+	return nil
+}
+
+func (s *memoryRoomStore) update(roomId string, f func(r *RoomRecord)) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	r, ok := s.rooms[roomId]
+	if !ok {
+		return ErrRoomNotFound
+	}
+	if r.Votes == nil {
+		r.Votes = make(map[string]string)
+	}
+	f(r)
+	return nil
+}
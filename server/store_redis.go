@@ -0,0 +1,331 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+const (
+	// redisRoomKeyPrefix ルームのレコードを保存するキーの接頭辞
+	redisRoomKeyPrefix = "planning-poker:rooms:"
+	// redisInviteKeyPrefix 招待コードからルームのIDを引くためのキーの接頭辞
+	redisInviteKeyPrefix = "planning-poker:invites:"
+	// redisRoomIndexKey 全てのルームのIDを保持するセットのキー
+	redisRoomIndexKey = "planning-poker:rooms"
+	// redisMaxRetries 他のインスタンスと同時に同じルームを更新した場合に、やり直す回数
+	redisMaxRetries = 16
+)
+
+// redisRoomStore ルームの状態をRedisに保存するRoomStore。
+// 複数のインスタンスで同じサーバを使うことで、ルームの状態を共有できる。ValkeyなどのRedisと互換性のあるサーバでも使える。
+// ルームごとにJSONのレコードを一つ保存し、更新はWATCHを使った楽観的なトランザクションで読み込みから書き込みまでを行う。
+type redisRoomStore struct {
+	client *redis.Client
+}
+
+// NewRedisRoomStore redis://[:password@]host:port/db の形式のurlで指定されたサーバを使うRoomStoreを返す
+func NewRedisRoomStore(url string) (*redisRoomStore, error) {
+	opts, err := redis.ParseURL(url)
+	if err != nil {
+		return nil, err
+	}
+	return &redisRoomStore{client: redis.NewClient(opts)}, nil
+}
+
+func (s *redisRoomStore) CreateRoom(ctx context.Context, room *RoomRecord) error {
+	r := room.clone()
+	r.initTimes()
+	b, err := json.Marshal(r)
+	if err != nil {
+		return fmt.Errorf("failed to marshal room: %w", err)
+	}
+	roomKey := redisRoomKeyPrefix + r.ID
+	inviteKey := redisInviteKeyPrefix + r.InviteCode
+
+	return s.transact(ctx, func(tx *redis.Tx) error {
+		n, err := tx.Exists(ctx, roomKey).Result()
+		if err != nil {
+			return err
+		}
+		if n > 0 {
+			return ErrExistRoom
+		}
+		if r.InviteCode != "" {
+			n, err := tx.Exists(ctx, inviteKey).Result()
+			if err != nil {
+				return err
+			}
+			if n > 0 {
+				return ErrExistInviteCode
+			}
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, roomKey, b, 0)
+			if r.InviteCode != "" {
+				pipe.Set(ctx, inviteKey, r.ID, 0)
+			}
+			pipe.SAdd(ctx, redisRoomIndexKey, r.ID)
+			return nil
+		})
+		return err
+	}, roomKey, inviteKey)
+}
+
+func (s *redisRoomStore) GetRoom(ctx context.Context, roomId string) (*RoomRecord, error) {
+	return getRedisRoom(ctx, s.client, roomId)
+}
+
+func (s *redisRoomStore) FindRoomByInviteCode(ctx context.Context, inviteCode string) (*RoomRecord, error) {
+	if inviteCode == "" {
+		return nil, ErrRoomNotFound
+	}
+	id, err := s.client.Get(ctx, redisInviteKeyPrefix+inviteCode).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrRoomNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return s.GetRoom(ctx, id)
+}
+
+func (s *redisRoomStore) DeleteRoom(ctx context.Context, roomId string) error {
+	roomKey := redisRoomKeyPrefix + roomId
+	return s.transact(ctx, func(tx *redis.Tx) error {
+		r, err := getRedisRoom(ctx, tx, roomId)
+		if err != nil {
+			return err
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Del(ctx, roomKey)
+			if r.InviteCode != "" {
+				pipe.Del(ctx, redisInviteKeyPrefix+r.InviteCode)
+			}
+			pipe.SRem(ctx, redisRoomIndexKey, roomId)
+			return nil
+		})
+		return err
+	}, roomKey)
+}
+
+func (s *redisRoomStore) ListRooms(ctx context.Context) ([]*RoomRecord, error) {
+	ids, err := s.client.SMembers(ctx, redisRoomIndexKey).Result()
+	if err != nil {
+		return nil, err
+	}
+	if len(ids) == 0 {
+		return []*RoomRecord{}, nil
+	}
+	keys := make([]string, len(ids))
+	for i, id := range ids {
+		keys[i] = redisRoomKeyPrefix + id
+	}
+	values, err := s.client.MGet(ctx, keys...).Result()
+	if err != nil {
+		return nil, err
+	}
+
+	rooms := make([]*RoomRecord, 0, len(values))
+	for i, v := range values {
+		// 一覧を取得してから読み込むまでの間に削除されたルームは含めない
+		str, ok := v.(string)
+		if !ok {
+			continue
+		}
+		r, err := parseRedisRoom(ids[i], str)
+		if err != nil {
+			return nil, err
+		}
+		rooms = append(rooms, r)
+	}
+	sort.Slice(rooms, func(i, j int) bool { return rooms[i].ID < rooms[j].ID })
+	return rooms, nil
+}
+
+func (s *redisRoomStore) PutVote(ctx context.Context, roomId, participant, card string) error {
+	return s.update(ctx, roomId, func(r *RoomRecord) {
+		r.Votes[participant] = card
+	})
+}
+
+func (s *redisRoomStore) ClearVote(ctx context.Context, roomId, participant string) error {
+	return s.update(ctx, roomId, func(r *RoomRecord) {
+		delete(r.Votes, participant)
+	})
+}
+
+func (s *redisRoomStore) StartRound(ctx context.Context, roomId string, startedAt time.Time) error {
+	return s.update(ctx, roomId, func(r *RoomRecord) {
+		r.Votes = make(map[string]string)
+		r.RoundStartedAt = startedAt
+	})
+}
+
+func (s *redisRoomStore) SaveRounds(ctx context.Context, roomId string, rounds []Round) error {
+	return s.update(ctx, roomId, func(r *RoomRecord) {
+		r.Rounds = rounds
+	})
+}
+
+func (s *redisRoomStore) AddParticipant(ctx context.Context, roomId, participant string, session Session) error {
+	return s.update(ctx, roomId, func(r *RoomRecord) {
+		r.addParticipant(participant, session)
+	})
+}
+
+func (s *redisRoomStore) RemoveParticipant(ctx context.Context, roomId, participant string) error {
+	return s.update(ctx, roomId, func(r *RoomRecord) {
+		r.removeParticipant(participant)
+	})
+}
+
+func (s *redisRoomStore) SuspendParticipant(ctx context.Context, roomId, participant, instance string) error {
+	var moved error
+	err := s.update(ctx, roomId, func(r *RoomRecord) {
+		moved = r.suspendParticipant(participant, instance)
+	})
+	if err != nil {
+		return err
+	}
+	return moved
+}
+
+func (s *redisRoomStore) RefreshSessions(ctx context.Context, roomId, instance string, expiresAt time.Time) error {
+	return s.update(ctx, roomId, func(r *RoomRecord) {
+		r.refreshSessions(instance, expiresAt)
+	})
+}
+
+// ExpireSessions トランザクションがやり直しになった場合は、最後に読み込んだレコードから外した参加者を返す
+func (s *redisRoomStore) ExpireSessions(ctx context.Context, roomId string, now time.Time) ([]string, error) {
+	var expired []string
+	err := s.update(ctx, roomId, func(r *RoomRecord) {
+		expired = r.expireSessions(now)
+	})
+	return expired, err
+}
+
+func (s *redisRoomStore) StartTimer(ctx context.Context, roomId string, timer *RoundTimer) error {
+	return s.update(ctx, roomId, func(r *RoomRecord) {
+		r.Timer = timer
+	})
+}
+
+// StopTimer 他のインスタンスと同時に消そうとした場合は、トランザクションをやり直して、先に消した方だけが真を返す
+func (s *redisRoomStore) StopTimer(ctx context.Context, roomId, id string) (bool, error) {
+	var stopped bool
+	err := s.update(ctx, roomId, func(r *RoomRecord) {
+		stopped = r.stopTimer(id)
+	})
+	return stopped, err
+}
+
+func (s *redisRoomStore) SetRole(ctx context.Context, roomId, participant string, role pokerv1.Role) error {
+	return s.update(ctx, roomId, func(r *RoomRecord) {
+		r.setRole(participant, role)
+	})
+}
+
+func (s *redisRoomStore) SaveSettings(ctx context.Context, roomId string, settings Settings) error {
+	return s.update(ctx, roomId, func(r *RoomRecord) {
+		r.Settings = settings
+	})
+}
+
+func (s *redisRoomStore) SaveResumeState(ctx context.Context, roomId, instance string, state *ResumeState) error {
+	return s.update(ctx, roomId, func(r *RoomRecord) {
+		r.saveResumeState(instance, state)
+	})
+}
+
+func (s *redisRoomStore) SetLocked(ctx context.Context, roomId string, locked bool) error {
+	return s.update(ctx, roomId, func(r *RoomRecord) {
+		r.Locked = locked
+	})
+}
+
+func (s *redisRoomStore) SaveStories(ctx context.Context, roomId string, stories []Story, current string) error {
+	return s.update(ctx, roomId, func(r *RoomRecord) {
+		r.Stories = stories
+		r.CurrentStory = current
+	})
+}
+
+func (s *redisRoomStore) Touch(ctx context.Context, roomId string, usedAt time.Time) error {
+	return s.update(ctx, roomId, func(r *RoomRecord) {
+		r.LastUsedAt = usedAt
+	})
+}
+
+func (s *redisRoomStore) Ping(ctx context.Context) error {
+	return s.client.Ping(ctx).Err()
+}
+
+func (s *redisRoomStore) Close() error {
+	return s.client.Close()
+}
+
+// update roomIdのルームのレコードを読み込んでfで更新し、書き戻す。
+// 読み込んでから書き戻すまでの間に他のインスタンスが同じルームを更新した場合は、読み込みからやり直す。
+// レコードはJSONにして書き戻すので、fに渡した値をレコードがそのまま参照してもよい。
+func (s *redisRoomStore) update(ctx context.Context, roomId string, f func(r *RoomRecord)) error {
+	roomKey := redisRoomKeyPrefix + roomId
+	return s.transact(ctx, func(tx *redis.Tx) error {
+		r, err := getRedisRoom(ctx, tx, roomId)
+		if err != nil {
+			return err
+		}
+		f(r)
+		b, err := json.Marshal(r)
+		if err != nil {
+			return fmt.Errorf("failed to marshal room: %w", err)
+		}
+		_, err = tx.TxPipelined(ctx, func(pipe redis.Pipeliner) error {
+			pipe.Set(ctx, roomKey, b, 0)
+			return nil
+		})
+		return err
+	}, roomKey)
+}
+
+// transact keysを監視してfを実行する。監視している間にkeysが更新されてトランザクションが失敗した場合は、やり直す
+func (s *redisRoomStore) transact(ctx context.Context, f func(tx *redis.Tx) error, keys ...string) error {
+	for i := 0; i < redisMaxRetries; i++ {
+		err := s.client.Watch(ctx, f, keys...)
+		if !errors.Is(err, redis.TxFailedErr) {
+			return err
+		}
+	}
+	return fmt.Errorf("failed to update %v: too many conflicts", keys)
+}
+
+// getRedisRoom roomIdのルームのレコードを読み込む
+func getRedisRoom(ctx context.Context, c redis.Cmdable, roomId string) (*RoomRecord, error) {
+	str, err := c.Get(ctx, redisRoomKeyPrefix+roomId).Result()
+	if errors.Is(err, redis.Nil) {
+		return nil, ErrRoomNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	return parseRedisRoom(roomId, str)
+}
+
+func parseRedisRoom(roomId, str string) (*RoomRecord, error) {
+	var r RoomRecord
+	if err := json.Unmarshal([]byte(str), &r); err != nil {
+		return nil, fmt.Errorf("failed to parse room %s: %w", roomId, err)
+	}
+	if r.Votes == nil {
+		r.Votes = make(map[string]string)
+	}
+	return &r, nil
+}
@@ -0,0 +1,351 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/alicebob/miniredis/v2"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+func TestRoomStore(t *testing.T) {
+	stores := map[string]func(t *testing.T) RoomStore{
+		"memory": func(t *testing.T) RoomStore {
+			return NewMemoryRoomStore()
+		},
+		"file": func(t *testing.T) RoomStore {
+			s, err := NewFileRoomStore(filepath.Join(t.TempDir(), "rooms.db"))
+			if err != nil {
+				t.Fatal(err)
+			}
+			return s
+		},
+		"redis": func(t *testing.T) RoomStore {
+			s, err := NewRedisRoomStore("redis://" + miniredis.RunT(t).Addr())
+			if err != nil {
+				t.Fatal(err)
+			}
+			return s
+		},
+	}
+
+	for name, newStore := range stores {
+		t.Run(name, func(t *testing.T) {
+			ctx := context.Background()
+			s := newStore(t)
+			defer s.Close()
+
+			if err := s.CreateRoom(ctx, &RoomRecord{ID: "room", InviteCode: "ABCD1234"}); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.CreateRoom(ctx, &RoomRecord{ID: "room"}); !errors.Is(err, ErrExistRoom) {
+				t.Fatalf("expected ErrExistRoom, got %v", err)
+			}
+			if err := s.CreateRoom(ctx, &RoomRecord{ID: "other", InviteCode: "ABCD1234"}); !errors.Is(err, ErrExistInviteCode) {
+				t.Fatalf("expected ErrExistInviteCode, got %v", err)
+			}
+			if r, err := s.FindRoomByInviteCode(ctx, "ABCD1234"); err != nil || r.ID != "room" {
+				t.Fatalf("expected room, got %v %v", r, err)
+			}
+			if _, err := s.GetRoom(ctx, "unknown"); !errors.Is(err, ErrRoomNotFound) {
+				t.Fatalf("expected ErrRoomNotFound, got %v", err)
+			}
+
+			if err := s.AddParticipant(ctx, "room", "Taro", Session{TokenHash: hashToken("taro"), Instance: "a"}); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.AddParticipant(ctx, "room", "Hanako", Session{TokenHash: hashToken("hanako"), Instance: "b"}); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.PutVote(ctx, "room", "Taro", "3"); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.PutVote(ctx, "room", "Hanako", "5"); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.ClearVote(ctx, "room", "Hanako"); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.SetRole(ctx, "room", "Hanako", pokerv1.Role_ROLE_FACILITATOR); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.RemoveParticipant(ctx, "room", "Hanako"); err != nil {
+				t.Fatal(err)
+			}
+
+			r, err := s.GetRoom(ctx, "room")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(r.Participants) != 1 || r.Participants[0] != "Taro" {
+				t.Fatalf("unexpected participants %v", r.Participants)
+			}
+			if role := r.roleOf("Hanako"); role != pokerv1.Role_ROLE_VOTER {
+				t.Fatalf("role must be removed with the participant, got %v", role)
+			}
+			if !r.authenticate("Taro", "taro") || r.authenticate("Taro", "hanako") {
+				t.Fatalf("unexpected sessions %v", r.Sessions)
+			}
+			if _, ok := r.Sessions["Hanako"]; ok {
+				t.Fatal("session must be removed with the participant")
+			}
+
+			// 他のインスタンスで接続を再開した参加者は、前のインスタンスで切断されても外さない
+			if err := s.SuspendParticipant(ctx, "room", "Taro", "b"); !errors.Is(err, ErrSessionMoved) {
+				t.Fatalf("expected ErrSessionMoved, got %v", err)
+			}
+			// 期限を延ばされなかったセッションの参加者だけを、セッションとロールを残して外す
+			now := time.Now()
+			if err := s.AddParticipant(ctx, "room", "Jiro", Session{Instance: "c", ExpiresAt: now.Add(-time.Second)}); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.SetRole(ctx, "room", "Jiro", pokerv1.Role_ROLE_FACILITATOR); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.RefreshSessions(ctx, "room", "a", now.Add(time.Minute)); err != nil {
+				t.Fatal(err)
+			}
+			expired, err := s.ExpireSessions(ctx, "room", now)
+			if err != nil || len(expired) != 1 || expired[0] != "Jiro" {
+				t.Fatalf("unexpected expired participants %v %v", expired, err)
+			}
+			if r, _ := s.GetRoom(ctx, "room"); len(r.Participants) != 1 || r.Participants[0] != "Taro" {
+				t.Fatalf("unexpected participants %v", r.Participants)
+			} else if _, ok := r.Sessions["Jiro"]; !ok || r.roleOf("Jiro") != pokerv1.Role_ROLE_FACILITATOR {
+				t.Fatalf("session and role must be kept, got %v %v", r.Sessions, r.Roles)
+			}
+			if len(r.Votes) != 1 || r.Votes["Taro"] != "3" {
+				t.Fatalf("unexpected votes %v", r.Votes)
+			}
+
+			// 返された値を書き換えてもストアには影響しない
+			r.Votes["Taro"] = "8"
+			r, _ = s.GetRoom(ctx, "room")
+			if r.Votes["Taro"] != "3" {
+				t.Fatalf("store was modified through returned record")
+			}
+
+			if err := s.SetLocked(ctx, "room", true); err != nil {
+				t.Fatal(err)
+			}
+			if r, _ := s.GetRoom(ctx, "room"); !r.Locked {
+				t.Fatalf("room was not locked")
+			}
+
+			// タイマーは、置き換えられていなければ一度だけ消せる
+			deadline := time.Date(2023, 9, 1, 0, 1, 0, 0, time.UTC)
+			if err := s.StartTimer(ctx, "room", &RoundTimer{ID: "old", Deadline: deadline, Duration: time.Minute}); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.StartTimer(ctx, "room", &RoundTimer{ID: "new", Deadline: deadline, Duration: time.Minute, AutoReveal: true}); err != nil {
+				t.Fatal(err)
+			}
+			if r, _ := s.GetRoom(ctx, "room"); r.Timer == nil || r.Timer.ID != "new" || !r.Timer.Deadline.Equal(deadline) || !r.Timer.AutoReveal {
+				t.Fatalf("unexpected timer %v", r.Timer)
+			}
+			if stopped, err := s.StopTimer(ctx, "room", "old"); err != nil || stopped {
+				t.Fatalf("replaced timer must not be stopped, got %v %v", stopped, err)
+			}
+			if stopped, err := s.StopTimer(ctx, "room", "new"); err != nil || !stopped {
+				t.Fatalf("timer was not stopped, got %v %v", stopped, err)
+			}
+			if stopped, _ := s.StopTimer(ctx, "room", "new"); stopped {
+				t.Fatal("timer must be stopped only once")
+			}
+
+			// 停止したインスタンスごとのイベントログは、他のインスタンスのものを上書きしない
+			if err := s.SuspendParticipant(ctx, "room", "Taro", "a"); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.SaveResumeState(ctx, "room", "a", &ResumeState{Sequence: 7}); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.SaveResumeState(ctx, "room", "b", &ResumeState{Sequence: 3}); err != nil {
+				t.Fatal(err)
+			}
+			r, _ = s.GetRoom(ctx, "room")
+			if len(r.ResumeLogs) != 2 || r.ResumeLogs["a"].Sequence != 7 || r.ResumeLogs["b"].Sequence != 3 {
+				t.Fatalf("unexpected resume logs %v", r.ResumeLogs)
+			}
+			// 切断した参加者は外すが、セッションは再開のために残す
+			if r.hasParticipant("Taro") || !r.suspendedBy("a") || r.suspendedBy("b") {
+				t.Fatalf("unexpected participants %v and sessions %v", r.Participants, r.Sessions)
+			}
+			if err := s.SaveResumeState(ctx, "room", "b", nil); err != nil {
+				t.Fatal(err)
+			}
+			if r, _ := s.GetRoom(ctx, "room"); len(r.ResumeLogs) != 1 || r.ResumeLogs["a"] == nil {
+				t.Fatalf("unexpected resume logs %v", r.ResumeLogs)
+			}
+			// 接続を再開して参照されなくなったイベントログは消える
+			if err := s.AddParticipant(ctx, "room", "Taro", Session{Instance: "d"}); err != nil {
+				t.Fatal(err)
+			}
+			if r, _ := s.GetRoom(ctx, "room"); len(r.ResumeLogs) != 0 {
+				t.Fatalf("resume logs must be pruned, got %v", r.ResumeLogs)
+			}
+
+			usedAt := time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)
+			if err := s.Touch(ctx, "room", usedAt); err != nil {
+				t.Fatal(err)
+			}
+			if err := s.StartRound(ctx, "room", usedAt); err != nil {
+				t.Fatal(err)
+			}
+			r, _ = s.GetRoom(ctx, "room")
+			if len(r.Votes) != 0 || !r.LastUsedAt.Equal(usedAt) || !r.RoundStartedAt.Equal(usedAt) {
+				t.Fatalf("unexpected room %+v", r)
+			}
+
+			if err := s.DeleteRoom(ctx, "room"); err != nil {
+				t.Fatal(err)
+			}
+			rooms, _ := s.ListRooms(ctx)
+			if len(rooms) != 0 {
+				t.Fatalf("expected no rooms, got %d", len(rooms))
+			}
+		})
+	}
+}
+
+func TestRedisRoomStoreConcurrentUpdates(t *testing.T) {
+	ctx := context.Background()
+	url := "redis://" + miniredis.RunT(t).Addr()
+
+	// 同じサーバを使う複数のインスタンスが、同じルームを同時に更新しても更新が失われない
+	stores := make([]*redisRoomStore, 4)
+	for i := range stores {
+		s, err := NewRedisRoomStore(url)
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer s.Close()
+		stores[i] = s
+	}
+	if err := stores[0].CreateRoom(ctx, &RoomRecord{ID: "room"}); err != nil {
+		t.Fatal(err)
+	}
+
+	var wg sync.WaitGroup
+	for i, s := range stores {
+		for j := 0; j < 4; j++ {
+			wg.Add(1)
+			go func(name string) {
+				defer wg.Done()
+				if err := s.AddParticipant(ctx, "room", name, Session{Instance: "a"}); err != nil {
+					t.Error(err)
+				}
+			}(fmt.Sprintf("p%d-%d", i, j))
+		}
+	}
+	wg.Wait()
+
+	r, err := stores[len(stores)-1].GetRoom(ctx, "room")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(r.Participants) != 16 {
+		t.Fatalf("expected 16 participants, got %v", r.Participants)
+	}
+}
+
+func TestFileRoomStoreSurvivesRestart(t *testing.T) {
+	ctx := context.Background()
+	path := filepath.Join(t.TempDir(), "rooms.db")
+
+	s, err := NewFileRoomStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateRoom(ctx, &RoomRecord{ID: "sprint"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.AddParticipant(ctx, "sprint", "Taro", Session{Instance: "a"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.PutVote(ctx, "sprint", "Taro", "5"); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.SaveStories(ctx, "sprint", []Story{{ID: "a", Title: "Login", Estimate: "5"}, {ID: "b", Title: "Logout"}}, "b"); err != nil {
+		t.Fatal(err)
+	}
+	// 最終利用日時は、閉じる際に書き出される
+	usedAt := time.Date(2023, 9, 1, 0, 0, 0, 0, time.UTC)
+	if err := s.Touch(ctx, "sprint", usedAt); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	s, err = NewFileRoomStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	r, err := s.GetRoom(ctx, "sprint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Votes["Taro"] != "5" {
+		t.Fatalf("vote was not restored: %v", r.Votes)
+	}
+	if len(r.Participants) != 0 {
+		t.Fatalf("participants should be reset on restart: %v", r.Participants)
+	}
+	if len(r.Stories) != 2 || r.Stories[0].Estimate != "5" || r.CurrentStory != "b" {
+		t.Fatalf("stories were not restored: %+v %s", r.Stories, r.CurrentStory)
+	}
+	if !r.LastUsedAt.Equal(usedAt) {
+		t.Fatalf("last used time was not restored: %v", r.LastUsedAt)
+	}
+}
+
+func TestFileRoomStoreRollsBackFailedWrites(t *testing.T) {
+	ctx := context.Background()
+	s, err := NewFileRoomStore(filepath.Join(t.TempDir(), "rooms.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.CreateRoom(ctx, &RoomRecord{ID: "sprint", InviteCode: "ABCD1234"}); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.PutVote(ctx, "sprint", "Taro", "5"); err != nil {
+		t.Fatal(err)
+	}
+
+	// ファイルに書き出せなかった変更は、メモリ上にも残らない
+	if err := s.db.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := s.PutVote(ctx, "sprint", "Taro", "8"); err == nil {
+		t.Fatal("expected an error when the file cannot be written")
+	}
+	if err := s.DeleteRoom(ctx, "sprint"); err == nil {
+		t.Fatal("expected an error when the file cannot be written")
+	}
+	if err := s.CreateRoom(ctx, &RoomRecord{ID: "retro"}); err == nil {
+		t.Fatal("expected an error when the file cannot be written")
+	}
+
+	r, err := s.GetRoom(ctx, "sprint")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.Votes["Taro"] != "5" {
+		t.Fatalf("failed vote was kept in memory: %v", r.Votes)
+	}
+	if r, err := s.FindRoomByInviteCode(ctx, "ABCD1234"); err != nil || r.ID != "sprint" {
+		t.Fatalf("invite code of the room was lost: %v %v", r, err)
+	}
+	if _, err := s.GetRoom(ctx, "retro"); !errors.Is(err, ErrRoomNotFound) {
+		t.Fatalf("failed room creation was kept in memory: %v", err)
+	}
+}
@@ -0,0 +1,284 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"connectrpc.com/connect"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+const (
+	// maxStories ルームに登録できるストーリーの最大件数
+	maxStories = 100
+	// maxStoryTitleLength ストーリーのタイトルの最大文字数
+	maxStoryTitleLength = 200
+)
+
+var (
+	ErrStoryNotFound     = errors.New("story not found")
+	ErrInvalidStoryTitle = errors.New("story title must not be empty")
+	ErrTooManyStories    = errors.New("too many stories")
+	ErrNoCurrentStory    = errors.New("no story is selected")
+	ErrInvalidStoryOrder = errors.New("story_ids must contain every story exactly once")
+)
+
+// Story ルームで見積もる1件のストーリー。
+// Estimateは、ファシリテータが最終的な見積もりとして受け入れたカードのラベル。
+type Story struct {
+	ID       string `json:"id"`
+	Title    string `json:"title"`
+	Estimate string `json:"estimate,omitempty"`
+}
+
+func (s Story) toProto() *pokerv1.Story {
+	return &pokerv1.Story{
+		Id:       s.ID,
+		Title:    s.Title,
+		Estimate: s.Estimate,
+	}
+}
+
+func storiesToProto(stories []Story) []*pokerv1.Story {
+	res := make([]*pokerv1.Story, 0, len(stories))
+	for _, s := range stories {
+		res = append(res, s.toProto())
+	}
+	return res
+}
+
+// newStoryID ストーリーのIDを生成する
+func newStoryID() string {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// findStory idのストーリーの位置を返す。見つからなければ-1を返す。
+func findStory(stories []Story, id string) int {
+	for i, s := range stories {
+		if s.ID == id {
+			return i
+		}
+	}
+	return -1
+}
+
+// nextStory currentより後にある、まだ見積もりが決まっていない最初のストーリーのIDを返す。
+// currentが空の場合は先頭から探す。見つからなければ空文字を返す。
+func nextStory(stories []Story, current string) string {
+	start := 0
+	if i := findStory(stories, current); i >= 0 {
+		start = i + 1
+	}
+	for _, s := range stories[start:] {
+		if s.Estimate == "" {
+			return s.ID
+		}
+	}
+	return ""
+}
+
+// storyNotFound ストーリーが見つからない場合のconnectのエラーを返す
+func storyNotFound(storyId string) error {
+	err := fmt.Errorf("%w: %s", ErrStoryNotFound, storyId)
+	return connect.NewError(
+		connect.CodeNotFound,
+		err,
+	)
+}
+
+// saveStories ストーリーの一覧と現在のストーリーを保存し、全員に通知する
+func (st *roomState) saveStories(ctx context.Context, stories []Story, current string) error {
+	if err := st.store.SaveStories(ctx, st.id, stories, current); err != nil {
+		return roomNotFoundOr(st.id, err)
+	}
+	st.broadcast(newStoriesChangedEvent(stories, current))
+	st.touch(ctx)
+	return nil
+}
+
+func (s *pokerServer) AddStory(ctx context.Context, req *connect.Request[pokerv1.AddStoryRequest]) (*connect.Response[pokerv1.AddStoryResponse], error) {
+	loggerFrom(ctx).Debug("AddStory function was invoked")
+
+	title := strings.TrimSpace(req.Msg.Title)
+	if title == "" || utf8.RuneCountInString(title) > maxStoryTitleLength {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			fmt.Errorf("%w and must be at most %d characters", ErrInvalidStoryTitle, maxStoryTitleLength),
+		)
+	}
+
+	story := Story{ID: newStoryID(), Title: title}
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		record, err := st.authorizeFacilitator(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+		if len(record.Stories) >= maxStories {
+			return connect.NewError(
+				connect.CodeResourceExhausted,
+				ErrTooManyStories,
+			)
+		}
+
+		// 最初のストーリーは、そのまま見積もり対象にする
+		current := record.CurrentStory
+		if current == "" && len(record.Stories) == 0 {
+			current = story.ID
+		}
+		return st.saveStories(ctx, append(record.Stories, story), current)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pokerv1.AddStoryResponse{
+		Story: story.toProto(),
+	}), nil
+}
+
+func (s *pokerServer) ReorderStories(ctx context.Context, req *connect.Request[pokerv1.ReorderStoriesRequest]) (*connect.Response[pokerv1.ReorderStoriesResponse], error) {
+	loggerFrom(ctx).Debug("ReorderStories function was invoked")
+
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		record, err := st.authorizeFacilitator(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+
+		if len(req.Msg.StoryIds) != len(record.Stories) {
+			return connect.NewError(
+				connect.CodeInvalidArgument,
+				ErrInvalidStoryOrder,
+			)
+		}
+		stories := make([]Story, 0, len(record.Stories))
+		seen := make(map[string]bool, len(req.Msg.StoryIds))
+		for _, id := range req.Msg.StoryIds {
+			i := findStory(record.Stories, id)
+			if i < 0 || seen[id] {
+				return connect.NewError(
+					connect.CodeInvalidArgument,
+					ErrInvalidStoryOrder,
+				)
+			}
+			seen[id] = true
+			stories = append(stories, record.Stories[i])
+		}
+		return st.saveStories(ctx, stories, record.CurrentStory)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pokerv1.ReorderStoriesResponse{
+		Message: "accepted",
+	}), nil
+}
+
+func (s *pokerServer) RemoveStory(ctx context.Context, req *connect.Request[pokerv1.RemoveStoryRequest]) (*connect.Response[pokerv1.RemoveStoryResponse], error) {
+	loggerFrom(ctx).Debug("RemoveStory function was invoked", "story_id", req.Msg.StoryId)
+
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		record, err := st.authorizeFacilitator(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+
+		i := findStory(record.Stories, req.Msg.StoryId)
+		if i < 0 {
+			return storyNotFound(req.Msg.StoryId)
+		}
+		stories := append(record.Stories[:i:i], record.Stories[i+1:]...)
+		current := record.CurrentStory
+		if current == req.Msg.StoryId {
+			current = ""
+		}
+		return st.saveStories(ctx, stories, current)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pokerv1.RemoveStoryResponse{
+		Message: "accepted",
+	}), nil
+}
+
+func (s *pokerServer) SelectStory(ctx context.Context, req *connect.Request[pokerv1.SelectStoryRequest]) (*connect.Response[pokerv1.SelectStoryResponse], error) {
+	loggerFrom(ctx).Debug("SelectStory function was invoked", "story_id", req.Msg.StoryId)
+
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		record, err := st.authorizeFacilitator(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+
+		if findStory(record.Stories, req.Msg.StoryId) < 0 {
+			return storyNotFound(req.Msg.StoryId)
+		}
+		return st.saveStories(ctx, record.Stories, req.Msg.StoryId)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pokerv1.SelectStoryResponse{
+		Message: "accepted",
+	}), nil
+}
+
+func (s *pokerServer) AcceptEstimate(ctx context.Context, req *connect.Request[pokerv1.AcceptEstimateRequest]) (*connect.Response[pokerv1.AcceptEstimateResponse], error) {
+	loggerFrom(ctx).Debug("AcceptEstimate function was invoked", "estimate", req.Msg.Estimate)
+
+	var story Story
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		record, err := st.authorizeFacilitator(ctx, req.Msg.Id)
+		if err != nil {
+			return err
+		}
+
+		storyId := req.Msg.StoryId
+		if storyId == "" {
+			storyId = record.CurrentStory
+		}
+		if storyId == "" {
+			return connect.NewError(
+				connect.CodeFailedPrecondition,
+				ErrNoCurrentStory,
+			)
+		}
+		i := findStory(record.Stories, storyId)
+		if i < 0 {
+			return storyNotFound(storyId)
+		}
+		if _, _, ok := record.Deck.find(req.Msg.Estimate); !ok {
+			err := fmt.Errorf("%w: %s", ErrCardNotInDeck, req.Msg.Estimate)
+			return connect.NewError(
+				connect.CodeInvalidArgument,
+				err,
+			)
+		}
+
+		record.Stories[i].Estimate = req.Msg.Estimate
+		story = record.Stories[i]
+		st.recordEstimate(ctx, record, storyId, req.Msg.Estimate)
+		return st.saveStories(ctx, record.Stories, record.CurrentStory)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pokerv1.AcceptEstimateResponse{
+		Story: story.toProto(),
+	}), nil
+}
@@ -0,0 +1,253 @@
+package main
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"math"
+	"strconv"
+	"time"
+
+	"connectrpc.com/connect"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+)
+
+// maxTimerDuration 1ラウンドのタイマーに設定できる最長の時間
+const maxTimerDuration = time.Hour
+
+// timerTickInterval タイマーの残り時間を通知する間隔
+const timerTickInterval = time.Second
+
+var ErrInvalidTimer = errors.New("duration_seconds must be between 1 and 3600")
+
+// roundTimer ストアに保存されたタイマーを、このインスタンスでカウントダウンしているもの。
+// ルームを管理している全てのインスタンスが、それぞれのクライアントに残り時間を通知する。
+// stopを閉じると、残り時間の通知と期限切れの処理を行うゴルーチンが終了する。
+type roundTimer struct {
+	RoundTimer
+	stop chan struct{}
+}
+
+func (t *RoundTimer) toProto(now time.Time) *pokerv1.RoundTimer {
+	return &pokerv1.RoundTimer{
+		Deadline:         timestamppb.New(t.Deadline),
+		DurationSeconds:  int32(t.Duration / time.Second),
+		AutoReveal:       t.AutoReveal,
+		RemainingSeconds: remainingSeconds(t.Deadline, now),
+	}
+}
+
+// newTimerID タイマーのIDを生成する
+func newTimerID() string {
+	b := make([]byte, 6)
+	if _, err := rand.Read(b); err != nil {
+		panic(err)
+	}
+	return hex.EncodeToString(b)
+}
+
+// remainingSeconds deadlineまでの残り時間を、秒単位に切り上げて返す
+func remainingSeconds(deadline, now time.Time) int32 {
+	d := deadline.Sub(now)
+	if d <= 0 {
+		return 0
+	}
+	return int32(math.Ceil(d.Seconds()))
+}
+
+// startTimer 現在のラウンドのタイマーを開始してストアに保存し、開始したことを全員に通知する。
+// 既に動いているタイマーは置き換える。
+func (st *roomState) startTimer(ctx context.Context, duration time.Duration, autoReveal bool) (*RoundTimer, error) {
+	t := &RoundTimer{
+		ID:         newTimerID(),
+		Deadline:   time.Now().Add(duration),
+		Duration:   duration,
+		AutoReveal: autoReveal,
+	}
+	if err := st.store.StartTimer(ctx, st.id, t); err != nil {
+		return nil, roomNotFoundOr(st.id, err)
+	}
+	st.followTimer(t)
+	st.broadcast(newTimerStartedEvent(t.toProto(time.Now())))
+	return t, nil
+}
+
+// followTimer ストアに保存されたタイマーtのカウントダウンを、このインスタンスでも始める。
+// 既に同じタイマーをカウントダウンしている場合は何もしない。
+func (st *roomState) followTimer(t *RoundTimer) {
+	if st.timer != nil && st.timer.ID == t.ID {
+		return
+	}
+	st.stopTimer()
+	st.timer = &roundTimer{RoundTimer: *t, stop: make(chan struct{})}
+	go runTimer(st.room, st.timer)
+}
+
+// stopTimer このインスタンスでのカウントダウンを止める。ストアのタイマーは消さず、止めたことも通知しない。
+func (st *roomState) stopTimer() {
+	if st.timer == nil {
+		return
+	}
+	close(st.timer.stop)
+	st.timer = nil
+}
+
+// clearTimer 投票を公開したり新しいラウンドを始めたりする際に、recordに保存されたタイマーを消して、カウントダウンを止める。
+// 他のインスタンスは、公開や新しいラウンドのイベントを受け取ってカウントダウンを止める。
+func (st *roomState) clearTimer(ctx context.Context, record *RoomRecord) {
+	st.stopTimer()
+	if record.Timer == nil {
+		return
+	}
+	if _, err := st.store.StopTimer(ctx, st.id, record.Timer.ID); err != nil && !errors.Is(err, ErrRoomNotFound) {
+		st.logger(ctx).Error("failed to stop timer", "error", err)
+	}
+}
+
+// runTimer タイマーのゴルーチン。残り時間の通知と期限切れの処理を、ルームのゴルーチンに依頼する。
+// 依頼が届くまでの間にタイマーが止められたり置き換えられたりしている場合、ルームのゴルーチンは何もしない。
+func runTimer(r *Room, t *roundTimer) {
+	ticker := time.NewTicker(timerTickInterval)
+	defer ticker.Stop()
+	expired := time.NewTimer(time.Until(t.Deadline))
+	defer expired.Stop()
+
+	ctx := context.Background()
+	for {
+		select {
+		case <-t.stop:
+			return
+		case now := <-ticker.C:
+			remaining := remainingSeconds(t.Deadline, now)
+			if remaining == 0 {
+				continue
+			}
+			// 他のインスタンスも同じタイマーをカウントダウンしているので、このインスタンスのクライアントにだけ配る
+			err := r.do(ctx, func(st *roomState) error {
+				if st.timer == t {
+					st.enqueue(newTimerTickEvent(remaining))
+				}
+				return nil
+			})
+			if err != nil {
+				return
+			}
+		case <-expired.C:
+			r.do(ctx, func(st *roomState) error {
+				if st.timer == t {
+					st.expireTimer(ctx)
+				}
+				return nil
+			})
+			return
+		}
+	}
+}
+
+// expireTimer タイマーの期限が切れたことを通知する。
+// 自動で公開する設定の場合は、ShowVotesと同様に投票を公開する。
+// カウントダウンしているインスタンスのうち、ストアからタイマーを消せた一つだけが処理する。
+func (st *roomState) expireTimer(ctx context.Context) {
+	t := st.timer
+	st.timer = nil
+	expired, err := st.store.StopTimer(ctx, st.id, t.ID)
+	if err != nil {
+		if !errors.Is(err, ErrRoomNotFound) {
+			st.logger(ctx).Error("failed to stop timer", "error", err)
+		}
+		return
+	}
+	if !expired {
+		return
+	}
+	autoReveal := t.AutoReveal
+	st.logger(ctx).Info("timer expired")
+
+	if !autoReveal {
+		st.broadcast(newTimerExpiredEvent(false))
+		return
+	}
+	record, err := st.store.GetRoom(ctx, st.id)
+	if err != nil {
+		st.logger(ctx).Error("failed to get room", "error", err)
+		st.broadcast(newTimerExpiredEvent(false))
+		return
+	}
+	revealed := len(record.Votes) > 0
+	st.broadcast(newTimerExpiredEvent(revealed))
+	if revealed {
+		st.reveal(ctx, record)
+	}
+}
+
+// timerStatus 参加した際のスナップショットに含める、recordに保存された動いているタイマーの状態を返す
+func timerStatus(record *RoomRecord) *pokerv1.RoundTimer {
+	if record.Timer == nil {
+		return nil
+	}
+	return record.Timer.toProto(time.Now())
+}
+
+func (s *pokerServer) StartTimer(ctx context.Context, req *connect.Request[pokerv1.StartTimerRequest]) (*connect.Response[pokerv1.StartTimerResponse], error) {
+	loggerFrom(ctx).Debug("StartTimer function was invoked", "duration_seconds", req.Msg.DurationSeconds)
+
+	duration := time.Duration(req.Msg.DurationSeconds) * time.Second
+	if duration <= 0 || duration > maxTimerDuration {
+		return nil, connect.NewError(
+			connect.CodeInvalidArgument,
+			ErrInvalidTimer,
+		)
+	}
+
+	var timer *pokerv1.RoundTimer
+	err := s.do(ctx, req.Msg.RoomId, func(st *roomState) error {
+		if _, err := st.authorizeFacilitator(ctx, req.Msg.Id); err != nil {
+			return err
+		}
+		t, err := st.startTimer(ctx, duration, req.Msg.AutoReveal)
+		if err != nil {
+			return err
+		}
+		timer = t.toProto(time.Now())
+		st.touch(ctx)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&pokerv1.StartTimerResponse{Timer: timer}), nil
+}
+
+func newTimerStartedEvent(timer *pokerv1.RoundTimer) *pokerv1.ConnectResponse {
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_TIMER_STARTED,
+		Message: strconv.Itoa(int(timer.RemainingSeconds)),
+		Event: &pokerv1.ConnectResponse_TimerStarted{
+			TimerStarted: &pokerv1.TimerStarted{Timer: timer},
+		},
+	}
+}
+
+func newTimerTickEvent(remaining int32) *pokerv1.ConnectResponse {
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_TIMER_TICK,
+		Message: strconv.Itoa(int(remaining)),
+		Event: &pokerv1.ConnectResponse_TimerTick{
+			TimerTick: &pokerv1.TimerTick{RemainingSeconds: remaining},
+		},
+	}
+}
+
+func newTimerExpiredEvent(revealed bool) *pokerv1.ConnectResponse {
+	return &pokerv1.ConnectResponse{
+		Type:    pokerv1.MessageType_MESSAGE_TYPE_TIMER_EXPIRED,
+		Message: strconv.FormatBool(revealed),
+		Event: &pokerv1.ConnectResponse_TimerExpired{
+			TimerExpired: &pokerv1.TimerExpired{Revealed: revealed},
+		},
+	}
+}
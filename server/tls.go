@@ -0,0 +1,118 @@
+package main
+
+import (
+	"crypto/tls"
+	"fmt"
+	"log/slog"
+	"net"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+)
+
+// newHTTPServer cfgに従ってhandlerを提供するサーバを作る。
+// ネイティブのgRPCクライアントはHTTP/2でしか通信できないので、TLSの場合はALPNで、
+// 平文の場合は事前の合意に基づくHTTP/2(h2c)を受け付け、Connect、gRPC-Web、gRPCのいずれでも呼び出せるようにする。
+// h2cもnet/httpのサーバで受け付けるので、Shutdownは平文のHTTP/2の接続でも処理中のRPCを待つ。
+func newHTTPServer(cfg Config, handler http.Handler) (*http.Server, error) {
+	srv := &http.Server{
+		Addr:    cfg.ListenAddr,
+		Handler: handler,
+		// TLSのハンドシェイクの失敗なども、他のログと同じ形式で出す
+		ErrorLog: slog.NewLogLogger(slog.Default().Handler(), slog.LevelWarn),
+	}
+	srv.Protocols = new(http.Protocols)
+	srv.Protocols.SetHTTP1(true)
+	if cfg.TLSCertFile == "" {
+		srv.Protocols.SetUnencryptedHTTP2(true)
+		return srv, nil
+	}
+
+	certs, err := newCertReloader(cfg.TLSCertFile, cfg.TLSKeyFile)
+	if err != nil {
+		return nil, err
+	}
+	srv.Protocols.SetHTTP2(true)
+	srv.TLSConfig = &tls.Config{
+		MinVersion:     tls.VersionTLS12,
+		GetCertificate: certs.GetCertificate,
+	}
+	return srv, nil
+}
+
+// serve lnで接続を受け付ける。srvにTLSが設定されていればTLSで受け付ける。
+func serve(srv *http.Server, ln net.Listener) error {
+	if srv.TLSConfig != nil {
+		return srv.ServeTLS(ln, "", "")
+	}
+	return srv.Serve(ln)
+}
+
+// certReloader TLSの証明書を、ファイルが更新されたら読み直して返す。
+// 証明書を更新するたびにサーバを再起動しなくて済むように、ハンドシェイクのたびに更新日時を確かめる。
+type certReloader struct {
+	certFile string
+	keyFile  string
+
+	mu      sync.Mutex
+	cert    *tls.Certificate
+	certMod time.Time
+	keyMod  time.Time
+}
+
+// newCertReloader certFileとkeyFileから証明書を読み込む。読み込めない場合はエラーを返す。
+func newCertReloader(certFile, keyFile string) (*certReloader, error) {
+	r := &certReloader{certFile: certFile, keyFile: keyFile}
+	certMod, keyMod, err := r.modTimes()
+	if err != nil {
+		return nil, err
+	}
+	if err := r.load(certMod, keyMod); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+// GetCertificate tls.ConfigのGetCertificateとして使う。
+// 読み直しに失敗した場合は、書き換えの途中の可能性があるので、ログに残して前の証明書を使い続ける。
+func (r *certReloader) GetCertificate(*tls.ClientHelloInfo) (*tls.Certificate, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	certMod, keyMod, err := r.modTimes()
+	if err == nil && (!certMod.Equal(r.certMod) || !keyMod.Equal(r.keyMod)) {
+		err = r.load(certMod, keyMod)
+		if err == nil {
+			slog.Info("TLS certificate is reloaded", "cert_file", r.certFile)
+		}
+	}
+	if err != nil {
+		slog.Error("failed to reload TLS certificate", "error", err)
+	}
+	return r.cert, nil
+}
+
+// load 証明書を読み込み、読み込んだ時点のファイルの更新日時と共に覚えておく
+func (r *certReloader) load(certMod, keyMod time.Time) error {
+	cert, err := tls.LoadX509KeyPair(r.certFile, r.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load TLS certificate: %w", err)
+	}
+	r.cert = &cert
+	r.certMod = certMod
+	r.keyMod = keyMod
+	return nil
+}
+
+func (r *certReloader) modTimes() (certMod, keyMod time.Time, err error) {
+	certInfo, err := os.Stat(r.certFile)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	keyInfo, err := os.Stat(r.keyFile)
+	if err != nil {
+		return time.Time{}, time.Time{}, err
+	}
+	return certInfo.ModTime(), keyInfo.ModTime(), nil
+}
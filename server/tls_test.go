@@ -0,0 +1,249 @@
+package main
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+
+	pokerv1 "github.com/machimachida/grpc-planning-poker/gen/proto/v1"
+	"github.com/machimachida/grpc-planning-poker/gen/proto/v1/pokerv1connect"
+)
+
+// writeTestCertificate 127.0.0.1に対する自己署名の証明書を作ってcertFileとkeyFileに書き出す
+func writeTestCertificate(t *testing.T, certFile, keyFile string, serial int64) *x509.Certificate {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(serial),
+		Subject:               pkix.Name{CommonName: "planning poker test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		IPAddresses:           []net.IP{net.IPv4(127, 0, 0, 1)},
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDer, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDer}), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert
+}
+
+// startTestServer cfgに従ったサーバを、ランダムなポートで起動してアドレスを返す
+func startTestServer(t *testing.T, cfg Config) string {
+	t.Helper()
+
+	srv, err := newHTTPServer(cfg, newServeMux(newPokerServer(NewMemoryRoomStore(), NewLocalBus(), cfg)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go serve(srv, ln)
+	t.Cleanup(func() { srv.Close() })
+	return ln.Addr().String()
+}
+
+// testProtocols Connect、gRPC-Web、HTTP/2の場合はgRPCでも、単項RPCとストリームを呼び出せることを確かめる
+func testProtocols(t *testing.T, httpClient *http.Client, baseURL string, useHTTP2 bool) {
+	t.Helper()
+
+	protocols := map[string][]connect.ClientOption{
+		"connect":  nil,
+		"grpc-web": {connect.WithGRPCWeb()},
+	}
+	if useHTTP2 {
+		protocols["grpc"] = []connect.ClientOption{connect.WithGRPC()}
+	}
+	for name, opts := range protocols {
+		t.Run(name, func(t *testing.T) {
+			client := pokerv1connect.NewPlanningPokerServiceClient(httpClient, baseURL, opts...)
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			roomId := fmt.Sprintf("%s-http2-%t", name, useHTTP2)
+			stream, err := client.CreateRoom(ctx, connect.NewRequest(&pokerv1.CreateRoomRequest{Id: "Taro", RoomId: roomId}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			receive(stream).expect(t, pokerv1.MessageType_MESSAGE_TYPE_CREATE_ROOM)
+
+			res, err := client.GetRoom(ctx, connect.NewRequest(&pokerv1.GetRoomRequest{RoomId: roomId}))
+			if err != nil {
+				t.Fatal(err)
+			}
+			if res.Msg.Room.RoomId != roomId {
+				t.Fatalf("unexpected room %v", res.Msg.Room)
+			}
+		})
+	}
+}
+
+func TestTLS(t *testing.T) {
+	dir := t.TempDir()
+	cfg := defaultConfig()
+	cfg.TLSCertFile = filepath.Join(dir, "cert.pem")
+	cfg.TLSKeyFile = filepath.Join(dir, "key.pem")
+	first := writeTestCertificate(t, cfg.TLSCertFile, cfg.TLSKeyFile, 1)
+	addr := startTestServer(t, cfg)
+
+	roots := x509.NewCertPool()
+	roots.AddCert(first)
+	// Transportは渡されたtls.ConfigのNextProtosを書き換えるので、それぞれに複製を渡す
+	tlsConfig := &tls.Config{RootCAs: roots}
+
+	// ネイティブのgRPCクライアントと同じく、HTTP/2だけで通信する
+	t.Run("http2", func(t *testing.T) {
+		transport := &http.Transport{TLSClientConfig: tlsConfig.Clone(), Protocols: new(http.Protocols)}
+		transport.Protocols.SetHTTP2(true)
+		testProtocols(t, &http.Client{Transport: transport}, "https://"+addr, true)
+	})
+	// ブラウザのようにHTTP/1.1で通信する
+	t.Run("http1", func(t *testing.T) {
+		testProtocols(t, &http.Client{Transport: &http.Transport{TLSClientConfig: tlsConfig.Clone()}}, "https://"+addr, false)
+	})
+
+	// 証明書のファイルが更新されたら、新しい接続から新しい証明書を使う
+	second := writeTestCertificate(t, cfg.TLSCertFile, cfg.TLSKeyFile, 2)
+	// 書き換えが速すぎて更新日時が変わらない場合があるので、明示的に進める
+	modTime := time.Now().Add(time.Minute)
+	for _, f := range []string{cfg.TLSCertFile, cfg.TLSKeyFile} {
+		if err := os.Chtimes(f, modTime, modTime); err != nil {
+			t.Fatal(err)
+		}
+	}
+	roots.AddCert(second)
+	conn, err := tls.Dial("tcp", addr, tlsConfig)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	if serial := conn.ConnectionState().PeerCertificates[0].SerialNumber; serial.Cmp(second.SerialNumber) != 0 {
+		t.Fatalf("expected reloaded certificate, got serial %v", serial)
+	}
+}
+
+// newH2CClient TLSを使わずに、最初からHTTP/2で通信するクライアントを返す
+func newH2CClient() *http.Client {
+	transport := &http.Transport{Protocols: new(http.Protocols)}
+	transport.Protocols.SetUnencryptedHTTP2(true)
+	return &http.Client{Transport: transport}
+}
+
+func TestH2C(t *testing.T) {
+	addr := startTestServer(t, defaultConfig())
+
+	t.Run("http2", func(t *testing.T) {
+		testProtocols(t, newH2CClient(), "http://"+addr, true)
+	})
+	t.Run("http1", func(t *testing.T) {
+		testProtocols(t, http.DefaultClient, "http://"+addr, false)
+	})
+}
+
+func TestH2CShutdown(t *testing.T) {
+	started := make(chan struct{})
+	release := make(chan struct{})
+	srv, err := newHTTPServer(defaultConfig(), http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		close(started)
+		<-release
+		fmt.Fprint(w, "done")
+	}))
+	if err != nil {
+		t.Fatal(err)
+	}
+	ln, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go serve(srv, ln)
+	t.Cleanup(func() { srv.Close() })
+
+	type result struct {
+		res *http.Response
+		err error
+	}
+	results := make(chan result, 1)
+	go func() {
+		res, err := newH2CClient().Get("http://" + ln.Addr().String())
+		results <- result{res, err}
+	}()
+	<-started
+
+	// 平文のHTTP/2の接続でも、処理中のリクエストが終わるまで停止を待つ
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	shutdown := make(chan error, 1)
+	go func() { shutdown <- srv.Shutdown(ctx) }()
+	select {
+	case err := <-shutdown:
+		t.Fatalf("shutdown returned while a request is in flight: %v", err)
+	case <-time.After(200 * time.Millisecond):
+	}
+	close(release)
+
+	r := <-results
+	if r.err != nil {
+		t.Fatal(r.err)
+	}
+	body, err := io.ReadAll(r.res.Body)
+	r.res.Body.Close()
+	if err != nil || string(body) != "done" || r.res.ProtoMajor != 2 {
+		t.Fatalf("unexpected response %s %q %v", r.res.Proto, body, err)
+	}
+	if err := <-shutdown; err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestTLSConfigValidation(t *testing.T) {
+	_, err := loadConfig([]string{"-tls-cert-file", "cert.pem"}, func(string) (string, bool) { return "", false })
+	if err == nil {
+		t.Fatal("expected error when tls-key-file is missing")
+	}
+
+	cfg := defaultConfig()
+	cfg.TLSCertFile = filepath.Join(t.TempDir(), "missing.pem")
+	cfg.TLSKeyFile = cfg.TLSCertFile
+	if _, err := newHTTPServer(cfg, http.NotFoundHandler()); err == nil {
+		t.Fatalf("expected error for missing certificate %s", cfg.TLSCertFile)
+	}
+}